@@ -85,6 +85,10 @@ func (handler *gaugeAPIMessageHandler) MessageBytesReceived(bytesRead []byte, co
 		case gauge_messages.APIMessage_FormatSpecsRequest:
 			responseMessage = handler.formatSpecs(apiMessage)
 			break
+		case gauge_messages.APIMessage_InlineConceptRequest:
+			responseMessage = handler.inlineConcept(apiMessage)
+			handler.performRefresh(responseMessage.InlineConceptResponse.FilesChanged)
+			break
 		default:
 			responseMessage = handler.createUnsupportedAPIMessageResponse(apiMessage)
 		}
@@ -239,6 +243,18 @@ func (handler *gaugeAPIMessageHandler) extractConcept(message *gauge_messages.AP
 	return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_ExtractConceptResponse, ExtractConceptResponse: response}
 }
 
+func (handler *gaugeAPIMessageHandler) inlineConcept(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
+	request := message.GetInlineConceptRequest()
+	refactoringResult := refactor.InlineConcept(request.GetConceptText(), request.GetFileName(), int(request.GetLineNumber()), request.GetRemoveDefinition(), handler.specInfoGatherer.SpecDirs)
+	if refactoringResult.Success {
+		logger.Infof(false, "%s", refactoringResult.String())
+	} else {
+		logger.Errorf(false, "Inline concept response from gauge. Errors : %s", refactoringResult.Errors)
+	}
+	response := &gauge_messages.InlineConceptResponse{Success: refactoringResult.Success, Errors: refactoringResult.Errors, FilesChanged: refactoringResult.AllFilesChanged()}
+	return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_InlineConceptResponse, InlineConceptResponse: response}
+}
+
 func (handler *gaugeAPIMessageHandler) formatSpecs(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
 	request := message.GetFormatSpecsRequest()
 	results := formatter.FormatSpecFiles(request.GetSpecs()...)
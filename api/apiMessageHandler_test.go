@@ -18,9 +18,13 @@
 package api
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/getgauge/gauge/api/infoGatherer"
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/parser"
@@ -59,3 +63,32 @@ func (s *MySuite) TestCreateSpecsResponseMessageFor(c *C) {
 	c.Assert(len(m.GetDetails()[2].ParseErrors), Equals, 0)
 	c.Assert(m.GetDetails()[2].Spec.GetSpecHeading(), Equals, "Spec heading 2")
 }
+
+func (s *MySuite) TestInlineConceptReplacesUsageWithConceptSteps(c *C) {
+	dir, err := ioutil.TempDir("", "gaugeAPI")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	c.Assert(os.Mkdir(filepath.Join(dir, "specs"), 0755), IsNil)
+	spec := filepath.Join(dir, "specs", "example.spec")
+	c.Assert(ioutil.WriteFile(spec, []byte("# Spec\n## Scenario\n* login as \"admin\"\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "specs", "example.cpt"), []byte("# login as <user>\n* enter <user>\n"), 0644), IsNil)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	h := &gaugeAPIMessageHandler{specInfoGatherer: &infoGatherer.SpecInfoGatherer{SpecDirs: []string{"specs"}}}
+
+	m := h.inlineConcept(&gauge_messages.APIMessage{
+		MessageId:            7,
+		MessageType:          gauge_messages.APIMessage_InlineConceptRequest,
+		InlineConceptRequest: &gauge_messages.InlineConceptRequest{ConceptText: "login as \"admin\"", FileName: spec, LineNumber: 3, RemoveDefinition: true},
+	})
+
+	c.Assert(m.GetMessageId(), Equals, int64(7))
+	c.Assert(m.GetMessageType(), Equals, gauge_messages.APIMessage_InlineConceptResponse)
+	c.Assert(m.GetInlineConceptResponse().GetErrors(), HasLen, 0)
+	c.Assert(m.GetInlineConceptResponse().GetSuccess(), Equals, true)
+	c.Assert(m.GetInlineConceptResponse().GetFilesChanged(), HasLen, 2)
+	content, err := ioutil.ReadFile(spec)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "# Spec\n## Scenario\n* enter \"admin\"\n")
+}
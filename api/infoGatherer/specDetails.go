@@ -40,7 +40,9 @@ type SpecInfoGatherer struct {
 	stepsCache        stepsCache
 	paramsCache       paramsCache
 	tagsCache         tagsCache
+	filesCache        filesCache
 	SpecDirs          []string
+	stopWatching      chan bool
 }
 
 type conceptCache struct {
@@ -69,6 +71,12 @@ type tagsCache struct {
 	tags  map[string][]string
 }
 
+type filesCache struct {
+	mutex  sync.RWMutex
+	files  []string
+	loaded bool
+}
+
 type SpecDetail struct {
 	Spec *gauge.Specification
 	Errs []parser.ParseError
@@ -84,6 +92,7 @@ func NewSpecInfoGatherer(conceptDictionary *gauge.ConceptDictionary) *SpecInfoGa
 
 // Init initializes all the SpecInfoGatherer caches
 func (s *SpecInfoGatherer) Init() {
+	s.stopWatching = make(chan bool)
 	go s.watchForFileChanges()
 	s.waitGroup.Wait()
 
@@ -425,6 +434,9 @@ func (s *SpecInfoGatherer) handleEvent(event fsnotify.Event, watcher *fsnotify.W
 		logger.Errorf(false, "Failed to get abs file path for %s: %s", event.Name, err)
 		return
 	}
+	if event.Op&(fsnotify.Create|fsnotify.Remove|fsnotify.Rename) != 0 {
+		s.onProjectFilesChange()
+	}
 	if util.IsSpec(file) || util.IsConcept(file) || util.IsDir(file) {
 		switch event.Op {
 		case fsnotify.Create:
@@ -448,7 +460,6 @@ func (s *SpecInfoGatherer) watchForFileChanges() {
 	}
 	defer watcher.Close()
 
-	done := make(chan bool)
 	go func() {
 		for {
 			select {
@@ -456,6 +467,8 @@ func (s *SpecInfoGatherer) watchForFileChanges() {
 				s.handleEvent(event, watcher)
 			case err := <-watcher.Errors:
 				logger.Errorf(false, "Error event while watching specs %s", err)
+			case <-s.stopWatching:
+				return
 			}
 		}
 	}()
@@ -469,11 +482,47 @@ func (s *SpecInfoGatherer) watchForFileChanges() {
 		allDirsToWatch = append(allDirsToWatch, util.FindAllNestedDirs(specDir)...)
 	}
 
+	// The other directories of the project are watched for the files which are added or removed.
+	allDirsToWatch = append(allDirsToWatch, util.FindProjectDirs()...)
+
+	watched := make(map[string]bool)
 	for _, dir := range allDirsToWatch {
-		addDirToFileWatcher(watcher, dir)
+		if !watched[dir] {
+			watched[dir] = true
+			addDirToFileWatcher(watcher, dir)
+		}
 	}
 	s.waitGroup.Done()
-	<-done
+	<-s.stopWatching
+}
+
+// Stop stops watching the project for file changes.
+func (s *SpecInfoGatherer) Stop() {
+	close(s.stopWatching)
+}
+
+// Files returns the files of the project, skipping hidden, ignored and dependency directories. The files are found
+// once, and again after a file is added to or removed from the project.
+func (s *SpecInfoGatherer) Files() []string {
+	s.filesCache.mutex.RLock()
+	if s.filesCache.loaded {
+		defer s.filesCache.mutex.RUnlock()
+		return s.filesCache.files
+	}
+	s.filesCache.mutex.RUnlock()
+	s.filesCache.mutex.Lock()
+	defer s.filesCache.mutex.Unlock()
+	if !s.filesCache.loaded {
+		s.filesCache.files = util.FindFilesInProject(func(path string) bool { return true })
+		s.filesCache.loaded = true
+	}
+	return s.filesCache.files
+}
+
+func (s *SpecInfoGatherer) onProjectFilesChange() {
+	s.filesCache.mutex.Lock()
+	defer s.filesCache.mutex.Unlock()
+	s.filesCache.files, s.filesCache.loaded = nil, false
 }
 
 // GetAvailableSpecs returns the list of all the specs in the gauge project
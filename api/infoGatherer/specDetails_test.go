@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/util"
@@ -511,6 +512,21 @@ func (s *MySuite) TestAllStepsOnFileRename(c *C) {
 	c.Assert(len(specInfoGatherer.AllSteps(true)), Equals, 2)
 }
 
+func (s *MySuite) TestFilesAreFoundAgainWhenAFileIsAdded(c *C) {
+	users, _ := createFileIn(s.projectDir, "users.csv", []byte("name"))
+	users, _ = filepath.Abs(users)
+	specInfoGatherer := &SpecInfoGatherer{SpecDirs: []string{s.specsDir}}
+
+	c.Assert(specInfoGatherer.Files(), DeepEquals, []string{users})
+
+	orders, _ := createFileIn(s.projectDir, "orders.csv", []byte("id"))
+	orders, _ = filepath.Abs(orders)
+	c.Assert(specInfoGatherer.Files(), DeepEquals, []string{users})
+
+	specInfoGatherer.handleEvent(fsnotify.Event{Name: orders, Op: fsnotify.Create}, nil)
+	c.Assert(specInfoGatherer.Files(), DeepEquals, []string{orders, users})
+}
+
 func createFileIn(dir string, fileName string, data []byte) (string, error) {
 	os.MkdirAll(dir, 0755)
 	err := ioutil.WriteFile(filepath.Join(dir, fileName), data, 0644)
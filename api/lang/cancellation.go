@@ -0,0 +1,97 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+// requestCancelled is the error code of the response to a request which is cancelled by the client.
+const requestCancelled = -32800
+
+// diagnosticsComputation is the key of the pending diagnostics computation, which is cancelled on shutdown.
+type diagnosticsComputation struct{}
+
+// pendingRequests keeps the cancel function of every request which is being handled, so that the request can be
+// cancelled when the client sends $/cancelRequest.
+type pendingRequests struct {
+	sync.Mutex
+	cancels map[interface{}]context.CancelFunc
+}
+
+var pending = &pendingRequests{cancels: make(map[interface{}]context.CancelFunc)}
+
+// start gives a context for the request with the given key, which is done when the request is cancelled.
+func (p *pendingRequests) start(ctx context.Context, key interface{}) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+	p.Lock()
+	defer p.Unlock()
+	p.cancels[key] = cancel
+	return ctx
+}
+
+func (p *pendingRequests) done(key interface{}) {
+	p.Lock()
+	defer p.Unlock()
+	if cancel, ok := p.cancels[key]; ok {
+		cancel()
+		delete(p.cancels, key)
+	}
+}
+
+func (p *pendingRequests) cancel(key interface{}) {
+	p.Lock()
+	defer p.Unlock()
+	if cancel, ok := p.cancels[key]; ok {
+		cancel()
+	}
+}
+
+// cancelOthers cancels every pending request other than the one with the given key.
+func (p *pendingRequests) cancelOthers(key interface{}) {
+	p.Lock()
+	defer p.Unlock()
+	for k, cancel := range p.cancels {
+		if k != key {
+			cancel()
+		}
+	}
+}
+
+func cancelRequest(req *jsonrpc2.Request) error {
+	var params lsp.CancelParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return fmt.Errorf("failed to parse request. %s", err.Error())
+	}
+	pending.cancel(jsonrpc2.ID{Num: params.ID.Num, Str: params.ID.Str, IsString: params.ID.IsString})
+	return nil
+}
+
+// cancelledError gives the error of the response to a request whose context is cancelled, or nil if it is not.
+func cancelledError(ctx context.Context) error {
+	if ctx.Err() != context.Canceled {
+		return nil
+	}
+	return &jsonrpc2.Error{Code: requestCancelled, Message: "request cancelled"}
+}
@@ -0,0 +1,98 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func TestCancelRequestCancelsContextOfRequest(t *testing.T) {
+	id := jsonrpc2.ID{Num: 7}
+	ctx := pending.start(context.Background(), id)
+	defer pending.done(id)
+	other := pending.start(context.Background(), jsonrpc2.ID{Num: 8})
+	defer pending.done(jsonrpc2.ID{Num: 8})
+
+	params, _ := json.Marshal(lsp.CancelParams{ID: lsp.ID{Num: 7}})
+	p := json.RawMessage(params)
+	if err := cancelRequest(&jsonrpc2.Request{Method: "$/cancelRequest", Params: &p, Notif: true}); err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+
+	if ctx.Err() != context.Canceled {
+		t.Errorf("want request 7 to be cancelled")
+	}
+	if other.Err() != nil {
+		t.Errorf("want request 8 not to be cancelled, got: `%v`", other.Err())
+	}
+	err, ok := cancelledError(ctx).(*jsonrpc2.Error)
+	if !ok || err.Code != requestCancelled {
+		t.Errorf("want: `%d`,\n got: `%v`", requestCancelled, cancelledError(ctx))
+	}
+}
+
+func TestCancelledErrorIsNilForRequestWhichIsNotCancelled(t *testing.T) {
+	if got := cancelledError(context.Background()); got != nil {
+		t.Errorf("want: `nil`,\n got: `%v`", got)
+	}
+}
+
+func TestStepCompletionStopsWhenCancelled(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", "# Specification Heading\n## Scenario Heading\n* ")
+	provider = &dummyInfoProvider{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	params, _ := json.Marshal(lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"},
+		Position:     lsp.Position{Line: 2, Character: 2},
+	})
+	p := json.RawMessage(params)
+
+	got, err := completion(ctx, &jsonrpc2.Request{Params: &p})
+
+	if err != context.Canceled {
+		t.Errorf("want: `%v`,\n got: `%v`", context.Canceled, err)
+	}
+	if got != nil {
+		t.Errorf("want no completions, got: `%v`", got)
+	}
+}
+
+func TestShutdownCancelsOtherPendingRequests(t *testing.T) {
+	shutdown := pending.start(context.Background(), jsonrpc2.ID{Num: 1})
+	defer pending.done(jsonrpc2.ID{Num: 1})
+	rename := pending.start(context.Background(), jsonrpc2.ID{Num: 2})
+	defer pending.done(jsonrpc2.ID{Num: 2})
+	diagnostics := pending.start(context.Background(), diagnosticsComputation{})
+	defer pending.done(diagnosticsComputation{})
+
+	pending.cancelOthers(jsonrpc2.ID{Num: 1})
+
+	if shutdown.Err() != nil {
+		t.Errorf("want shutdown request not to be cancelled, got: `%v`", shutdown.Err())
+	}
+	if rename.Err() != context.Canceled || diagnostics.Err() != context.Canceled {
+		t.Errorf("want pending request and diagnostics computation to be cancelled")
+	}
+}
@@ -80,22 +80,67 @@ type documentSelector struct {
 var clientCapabilities ClientCapabilities
 
 type ClientCapabilities struct {
-	SaveFiles bool `json:"saveFiles,omitempty"`
+	SaveFiles bool                     `json:"saveFiles,omitempty"`
+	Window    windowClientCapabilities `json:"window,omitempty"`
 }
 
-func gaugeLSPCapabilities() lsp.InitializeResult {
+type windowClientCapabilities struct {
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+type initializeResult struct {
+	Capabilities serverCapabilities `json:"capabilities,omitempty"`
+}
+
+// serverCapabilities adds the capabilities which are missing in lsp.ServerCapabilities.
+type serverCapabilities struct {
+	lsp.ServerCapabilities
+	ImplementationProvider bool                   `json:"implementationProvider,omitempty"`
+	FoldingRangeProvider   bool                   `json:"foldingRangeProvider,omitempty"`
+	ExecuteCommandProvider *executeCommandOptions `json:"executeCommandProvider,omitempty"`
+	Workspace              *workspaceCapabilities `json:"workspace,omitempty"`
+}
+
+type executeCommandOptions struct {
+	Commands []string `json:"commands"`
+}
+
+type workspaceCapabilities struct {
+	WorkspaceFolders workspaceFoldersCapabilities `json:"workspaceFolders"`
+}
+
+type workspaceFoldersCapabilities struct {
+	Supported           bool `json:"supported"`
+	ChangeNotifications bool `json:"changeNotifications"`
+}
+
+func gaugeLSPCapabilities() initializeResult {
 	kind := lsp.TDSKFull
-	return lsp.InitializeResult{
-		Capabilities: lsp.ServerCapabilities{
-			TextDocumentSync:           lsp.TextDocumentSyncOptionsOrKind{Kind: &kind, Options: &lsp.TextDocumentSyncOptions{Save: &lsp.SaveOptions{IncludeText: true}}},
-			CompletionProvider:         &lsp.CompletionOptions{ResolveProvider: true, TriggerCharacters: []string{"*", "* ", "\"", "<", ":", ","}},
-			DocumentFormattingProvider: true,
-			CodeLensProvider:           &lsp.CodeLensOptions{ResolveProvider: false},
-			DefinitionProvider:         true,
-			CodeActionProvider:         true,
-			DocumentSymbolProvider:     true,
-			WorkspaceSymbolProvider:    true,
-			RenameProvider:             true,
+	return initializeResult{
+		Capabilities: serverCapabilities{
+			ServerCapabilities: lsp.ServerCapabilities{
+				TextDocumentSync:                 lsp.TextDocumentSyncOptionsOrKind{Kind: &kind, Options: &lsp.TextDocumentSyncOptions{Save: &lsp.SaveOptions{IncludeText: true}}},
+				CompletionProvider:               &lsp.CompletionOptions{ResolveProvider: true, TriggerCharacters: []string{"*", "* ", "\"", "<", ":", ","}},
+				DocumentFormattingProvider:       true,
+				DocumentRangeFormattingProvider:  true,
+				DocumentOnTypeFormattingProvider: &lsp.DocumentOnTypeFormattingOptions{FirstTriggerCharacter: "|", MoreTriggerCharacter: []string{"\n"}},
+				CodeLensProvider:                 &lsp.CodeLensOptions{ResolveProvider: false},
+				DefinitionProvider:               true,
+				HoverProvider:                    true,
+				SignatureHelpProvider:            &lsp.SignatureHelpOptions{TriggerCharacters: []string{"\"", "<"}},
+				CodeActionProvider:               true,
+				DocumentSymbolProvider:           true,
+				WorkspaceSymbolProvider:          true,
+				RenameProvider:                   true,
+				ReferencesProvider:               true,
+				DocumentHighlightProvider:        true,
+			},
+			ImplementationProvider: true,
+			FoldingRangeProvider:   true,
+			ExecuteCommandProvider: &executeCommandOptions{Commands: []string{quickFixCommand}},
+			Workspace: &workspaceCapabilities{
+				WorkspaceFolders: workspaceFoldersCapabilities{Supported: true, ChangeNotifications: true},
+			},
 		},
 	}
 }
@@ -110,7 +155,7 @@ func registerFileWatcher(conn jsonrpc2.JSONRPC2, ctx context.Context) {
 	}
 	var result interface{}
 	conn.Call(ctx, "client/registerCapability", registrationParams{[]registration{
-		{Id: "gauge-fileWatcher", Method: "workspace/didChangeWatchedFiles", RegisterOptions: regParams},
+		{Id: registrationID("gauge-fileWatcher"), Method: "workspace/didChangeWatchedFiles", RegisterOptions: regParams},
 	}}, &result)
 }
 
@@ -138,10 +183,10 @@ func registerRunnerCapabilities(conn jsonrpc2.JSONRPC2, ctx context.Context) err
 	}
 	var result interface{}
 	var registrations = []registration{
-		{Id: "gauge-runner-didOpen", Method: "textDocument/didOpen", RegisterOptions: textDocumentRegistrationOptions{DocumentSelector: documentSelectors}},
-		{Id: "gauge-runner-didClose", Method: "textDocument/didClose", RegisterOptions: textDocumentRegistrationOptions{DocumentSelector: documentSelectors}},
-		{Id: "gauge-runner-didChange", Method: "textDocument/didChange", RegisterOptions: textDocumentChangeRegistrationOptions{textDocumentRegistrationOptions: textDocumentRegistrationOptions{DocumentSelector: documentSelectors}, SyncKind: lsp.TDSKFull}},
-		{Id: "gauge-runner-fileWatcher", Method: "workspace/didChangeWatchedFiles", RegisterOptions: didChangeWatchedFilesRegistrationOptions{Watchers: filePatterns}},
+		{Id: registrationID("gauge-runner-didOpen"), Method: "textDocument/didOpen", RegisterOptions: textDocumentRegistrationOptions{DocumentSelector: documentSelectors}},
+		{Id: registrationID("gauge-runner-didClose"), Method: "textDocument/didClose", RegisterOptions: textDocumentRegistrationOptions{DocumentSelector: documentSelectors}},
+		{Id: registrationID("gauge-runner-didChange"), Method: "textDocument/didChange", RegisterOptions: textDocumentChangeRegistrationOptions{textDocumentRegistrationOptions: textDocumentRegistrationOptions{DocumentSelector: documentSelectors}, SyncKind: lsp.TDSKFull}},
+		{Id: registrationID("gauge-runner-fileWatcher"), Method: "workspace/didChangeWatchedFiles", RegisterOptions: didChangeWatchedFilesRegistrationOptions{Watchers: filePatterns}},
 	}
 	registrations = addReferenceCodeLensRegistration(registrations, documentSelectors)
 	conn.Call(ctx, "client/registerCapability", registrationParams{registrations}, &result)
@@ -152,7 +197,7 @@ func addReferenceCodeLensRegistration(registrations []registration, selectors []
 	if enabled, err := strconv.ParseBool(os.Getenv("gauge_lsp_reference_codelens")); err == nil && !enabled {
 		return registrations
 	}
-	codeLensRegistration := registration{Id: "gauge-runner-codelens",
+	codeLensRegistration := registration{Id: registrationID("gauge-runner-codelens"),
 		Method: "textDocument/codeLens",
 		RegisterOptions: codeLensRegistrationOptions{
 			textDocumentRegistrationOptions: textDocumentRegistrationOptions{
@@ -20,6 +20,7 @@ package lang
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/getgauge/gauge/gauge"
@@ -47,7 +48,12 @@ func codeActions(req *jsonrpc2.Request) (interface{}, error) {
 func getSpecCodeAction(params lsp.CodeActionParams) ([]lsp.Command, error) {
 	var actions []lsp.Command
 	for _, d := range params.Context.Diagnostics {
+		if fixes, ok := getQuickFixActions(params.TextDocument.URI, d); ok {
+			actions = appendNewActions(actions, fixes)
+			continue
+		}
 		if d.Code != "" {
+			actions = append(actions, changeToClosestStep(params.TextDocument.URI, d.Range.Start.Line)...)
 			actions = append(actions, createCodeAction(generateStepCommand, generateStubTitle, []interface{}{d.Code}))
 			cptInfo, err := createConceptInfo(params.TextDocument.URI, params.Range.Start.Line)
 			if err != nil {
@@ -56,9 +62,31 @@ func getSpecCodeAction(params lsp.CodeActionParams) ([]lsp.Command, error) {
 			actions = append(actions, createCodeAction(generateConceptCommand, generateConceptTitle, []interface{}{cptInfo}))
 		}
 	}
+	if len(actions) == 0 {
+		actions = getInlineConceptActions(params.TextDocument.URI, params.Range.Start.Line)
+	}
 	return actions, nil
 }
 
+// appendNewActions appends the actions which are not offered yet, as more than one diagnostic can have the same fix.
+func appendNewActions(actions, newActions []lsp.Command) []lsp.Command {
+	for _, action := range newActions {
+		if !containsAction(actions, action) {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+func containsAction(actions []lsp.Command, action lsp.Command) bool {
+	for _, a := range actions {
+		if reflect.DeepEqual(a, action) {
+			return true
+		}
+	}
+	return false
+}
+
 func createConceptInfo(uri lsp.DocumentURI, line int) (interface{}, error) {
 	file := util.ConvertURItoFilePath(uri)
 	linetext := getLine(uri, line)
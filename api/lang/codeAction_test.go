@@ -240,3 +240,47 @@ func TestGetCodeActionForUnimplementedStepWithFileParameter(t *testing.T) {
 		t.Errorf("want: `%s`,\n got: `%s`", want, got)
 	}
 }
+
+func TestGetCodeActionForConceptUsage(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add(lsp.DocumentURI("foo.spec"), "# spec heading\n## scenario heading\n* concept1")
+	provider = &dummyInfoProvider{}
+
+	codeActionParams := lsp.CodeActionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"},
+		Range: lsp.Range{
+			Start: lsp.Position{Line: 2, Character: 0},
+			End:   lsp.Position{Line: 2, Character: 10},
+		},
+	}
+	b, _ := json.Marshal(codeActionParams)
+	p := json.RawMessage(b)
+
+	want := []lsp.Command{
+		{
+			Command:   inlineConceptCommand,
+			Title:     inlineConceptTitle,
+			Arguments: []interface{}{inlineConceptInfo{URI: "foo.spec", Line: 2}},
+		},
+		{
+			Command:   inlineConceptCommand,
+			Title:     inlineAllConceptTitle,
+			Arguments: []interface{}{inlineConceptInfo{URI: "foo.spec", Line: 2, AllUsages: true}},
+		},
+		{
+			Command:   inlineConceptCommand,
+			Title:     inlineAndRemoveConceptTitle,
+			Arguments: []interface{}{inlineConceptInfo{URI: "foo.spec", Line: 2, AllUsages: true, RemoveDefinition: true}},
+		},
+	}
+
+	got, err := codeActions(&jsonrpc2.Request{Params: &p})
+
+	if err != nil {
+		t.Errorf("expected error to be nil. \nGot : %s", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got)
+	}
+}
@@ -18,6 +18,7 @@
 package lang
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 
@@ -49,7 +50,7 @@ type completionList struct {
 	Items        []completionItem `json:"items"`
 }
 
-func completion(req *jsonrpc2.Request) (interface{}, error) {
+func completion(ctx context.Context, req *jsonrpc2.Request) (interface{}, error) {
 	var params lsp.TextDocumentPositionParams
 	if err := json.Unmarshal(*req.Params, &params); err != nil {
 		return nil, err
@@ -68,7 +69,7 @@ func completion(req *jsonrpc2.Request) (interface{}, error) {
 	if inParameterContext(line, params.Position.Character) {
 		return paramCompletion(line, pLine, params)
 	}
-	v, err := stepCompletion(line, pLine, params)
+	v, err := stepCompletion(ctx, line, pLine, params)
 	if err != nil && v != nil {
 		// there were errors, but gauge will return completions on a best effort promise.
 		logError(req, err.Error())
@@ -18,29 +18,51 @@
 package lang
 
 import (
+	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/util"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 )
 
+const (
+	fileParamPrefix  = "file:"
+	tableParamPrefix = "table:"
+	csvFileExtension = ".csv"
+)
+
+type paramSuggestion struct {
+	value   string
+	argType gauge.ArgType
+}
+
 func paramCompletion(line, pLine string, params lsp.TextDocumentPositionParams) (interface{}, error) {
 	list := completionList{IsIncomplete: false, Items: []completionItem{}}
 	argType, suffix, editRange := getParamArgTypeAndEditRange(line, pLine, params.Position)
-	file := util.ConvertURItoFilePath(params.TextDocument.URI)
-	for _, param := range provider.Params(file, argType) {
-		if !shouldAddParam(param.ArgType) {
-			continue
+	var suggestions []paramSuggestion
+	if argType == gauge.Dynamic {
+		suggestions = dynamicParamSuggestions(pLine[editRange.Start.Character:], params.TextDocument.URI, params.Position.Line)
+	} else {
+		file := util.ConvertURItoFilePath(params.TextDocument.URI)
+		for _, param := range provider.Params(file, argType) {
+			if shouldAddParam(param.ArgType) {
+				suggestions = append(suggestions, paramSuggestion{value: param.ArgValue(), argType: argType})
+			}
 		}
-		argValue := param.ArgValue()
+	}
+	for i, suggestion := range rankByUsage(suggestions) {
 		list.Items = append(list.Items, completionItem{
 			CompletionItem: lsp.CompletionItem{
-				Label:      argValue,
-				FilterText: argValue + suffix,
-				Detail:     string(argType),
+				Label:      suggestion.value,
+				FilterText: suggestion.value + suffix,
+				Detail:     string(suggestion.argType),
 				Kind:       lsp.CIKVariable,
-				TextEdit:   &lsp.TextEdit{Range: editRange, NewText: argValue + suffix},
+				SortText:   fmt.Sprintf("%04d", i),
+				TextEdit:   &lsp.TextEdit{Range: editRange, NewText: suggestion.value + suffix},
 			},
 			InsertTextFormat: text,
 		})
@@ -48,6 +70,97 @@ func paramCompletion(line, pLine string, params lsp.TextDocumentPositionParams)
 	return list, nil
 }
 
+// dynamicParamSuggestions gives project files for special params, or else the dynamic params which can be used at the line.
+func dynamicParamSuggestions(typed string, uri lsp.DocumentURI, line int) []paramSuggestion {
+	var suggestions []paramSuggestion
+	switch {
+	case strings.HasPrefix(typed, fileParamPrefix):
+		for _, file := range projectFiles(func(path string) bool { return true }) {
+			suggestions = append(suggestions, paramSuggestion{value: fileParamPrefix + file, argType: gauge.SpecialString})
+		}
+	case strings.HasPrefix(typed, tableParamPrefix):
+		for _, file := range projectFiles(func(path string) bool { return strings.ToLower(filepath.Ext(path)) == csvFileExtension }) {
+			suggestions = append(suggestions, paramSuggestion{value: tableParamPrefix + file, argType: gauge.SpecialTable})
+		}
+	default:
+		for _, name := range dynamicParamsAt(uri, line) {
+			suggestions = append(suggestions, paramSuggestion{value: name, argType: gauge.Dynamic})
+		}
+	}
+	return suggestions
+}
+
+func projectFiles(isValidFile func(path string) bool) []string {
+	var files []string
+	for _, file := range provider.Files() {
+		if isValidFile(file) {
+			files = append(files, filepath.ToSlash(util.RelPathToProjectRoot(file)))
+		}
+	}
+	return files
+}
+
+// dynamicParamsAt gives the dynamic params which can be used at the line. These are the params of the concept in
+// a concept file, and the data table columns of the spec and of the scenario in a spec.
+func dynamicParamsAt(uri lsp.DocumentURI, line int) []string {
+	file := util.ConvertURItoFilePath(uri)
+	var names []string
+	if util.IsConcept(file) {
+		concepts, _ := new(parser.ConceptParser).Parse(getContent(uri), file)
+		sort.Slice(concepts, func(i, j int) bool { return concepts[i].LineNo < concepts[j].LineNo })
+		var current *gauge.Step
+		for _, concept := range concepts {
+			if concept.LineNo-1 <= line {
+				current = concept
+			}
+		}
+		if current == nil {
+			return nil
+		}
+		for _, arg := range current.Args {
+			if arg.ArgType == gauge.Dynamic {
+				names = append(names, arg.Value)
+			}
+		}
+		return names
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText(getContent(uri), file)
+	for _, scenario := range spec.Scenarios {
+		if scenario.InSpan(line+1) && scenario.DataTable.Table.IsInitialized() {
+			names = append(names, scenario.DataTable.Table.Headers...)
+		}
+	}
+	if spec.DataTable.Table.IsInitialized() {
+		names = append(names, spec.DataTable.Table.Headers...)
+	}
+	return names
+}
+
+// rankByUsage removes the duplicate suggestions and orders them by how often they are used in the project.
+func rankByUsage(suggestions []paramSuggestion) []paramSuggestion {
+	usages := make(map[string]int)
+	for _, step := range provider.AllSteps(false) {
+		for _, arg := range step.Args {
+			usages[arg.ArgValue()]++
+		}
+	}
+	var ranked []paramSuggestion
+	added := make(map[string]bool)
+	for _, suggestion := range suggestions {
+		if !added[suggestion.value] {
+			added[suggestion.value] = true
+			ranked = append(ranked, suggestion)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if usages[ranked[i].value] != usages[ranked[j].value] {
+			return usages[ranked[i].value] > usages[ranked[j].value]
+		}
+		return ranked[i].value < ranked[j].value
+	})
+	return ranked
+}
+
 func shouldAddParam(argType gauge.ArgType) bool {
 	return argType != gauge.TableArg
 }
@@ -18,10 +18,17 @@
 package lang
 
 import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
 	"testing"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
 )
 
 var testParamEditPosition = []struct {
@@ -131,3 +138,93 @@ func TestShouldAddParam(t *testing.T) {
 		}
 	}
 }
+
+type usageInfoProvider struct {
+	dummyInfoProvider
+	steps []*gauge.Step
+	files []string
+}
+
+func (p usageInfoProvider) Files() []string {
+	return p.files
+}
+
+func (p usageInfoProvider) AllSteps(filterConcepts bool) []*gauge.Step {
+	return p.steps
+}
+
+func stepUsing(params ...string) *gauge.Step {
+	step := &gauge.Step{}
+	for _, param := range params {
+		step.Args = append(step.Args, &gauge.StepArg{Name: param, Value: param, ArgType: gauge.Dynamic})
+	}
+	return step
+}
+
+func getParamCompletionLabels(t *testing.T, uri lsp.DocumentURI, position lsp.Position) []string {
+	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}, Position: position})
+	p := json.RawMessage(b)
+	got, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Expected error == nil in Completion, got %s", err.Error())
+	}
+	var labels []string
+	for _, item := range got.(completionList).Items {
+		labels = append(labels, item.Label)
+	}
+	return labels
+}
+
+func TestParamCompletionInConceptGivesConceptParams(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.cpt")
+	openFilesCache.add(uri, "# first <foo>\n* a step\n\n# login as <user> with <password>\n* enter <")
+	provider = usageInfoProvider{steps: []*gauge.Step{stepUsing("password"), stepUsing("foo")}}
+
+	got := getParamCompletionLabels(t, uri, lsp.Position{Line: 4, Character: len("* enter <")})
+
+	want := []string{"password", "user"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestParamCompletionInSpecGivesDataTableColumnsRankedByUsage(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n|name|email|age|\n|----|-----|---|\n|foo |bar  |1  |\n## Scenario\n* login with <")
+	provider = usageInfoProvider{steps: []*gauge.Step{stepUsing("email", "name"), stepUsing("email")}}
+
+	got := getParamCompletionLabels(t, uri, lsp.Position{Line: 5, Character: len("* login with <")})
+
+	want := []string{"email", "name", "age"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestParamCompletionForSpecialParamsGivesProjectFiles(t *testing.T) {
+	dir, err := filepath.Abs("_testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	projectRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = projectRoot }()
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* read <file:\n* check <table:")
+	provider = usageInfoProvider{files: []string{filepath.Join(dir, "data", "notes.txt"), filepath.Join(dir, "data", "users.csv")}}
+
+	got := getParamCompletionLabels(t, uri, lsp.Position{Line: 2, Character: len("* read <file:")})
+	want := []string{"file:data/notes.txt", "file:data/users.csv"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+
+	got = getParamCompletionLabels(t, uri, lsp.Position{Line: 3, Character: len("* check <table:")})
+	want = []string{"table:data/users.csv"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
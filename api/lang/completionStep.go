@@ -18,6 +18,7 @@
 package lang
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -27,7 +28,8 @@ import (
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 )
 
-func stepCompletion(line, pLine string, params lsp.TextDocumentPositionParams) (interface{}, error) {
+// stepCompletion gives the concepts and steps which can complete the line. Nothing is given once the context is done.
+func stepCompletion(ctx context.Context, line, pLine string, params lsp.TextDocumentPositionParams) (interface{}, error) {
 	list := completionList{IsIncomplete: false, Items: make([]completionItem, 0)}
 	editRange := getStepEditRange(line, params.Position)
 	prefix := getPrefix(pLine)
@@ -40,9 +42,15 @@ func stepCompletion(line, pLine string, params lsp.TextDocumentPositionParams) (
 		cText := prefix + addPlaceHolders(c.StepValue.StepValue, c.StepValue.Parameters)
 		list.Items = append(list.Items, newStepCompletionItem(c.StepValue.ParameterizedStepValue, cText, concept, fText, editRange))
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	s, err := allImplementedStepValues()
 	allSteps := append(allUsedStepValues(), s...)
 	for _, sv := range removeDuplicates(allSteps) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		fText := prefix + getStepFilterText(sv.StepValue, sv.Args, givenArgs)
 		cText := prefix + addPlaceHolders(sv.StepValue, sv.Args)
 		list.Items = append(list.Items, newStepCompletionItem(sv.ParameterizedStepValue, cText, step, fText, editRange))
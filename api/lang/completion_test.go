@@ -18,6 +18,7 @@
 package lang
 
 import (
+	"context"
 	"encoding/json"
 	"reflect"
 	"testing"
@@ -109,10 +110,16 @@ func (p dummyInfoProvider) Tags() []string {
 	return []string{"hello"}
 }
 
+func (p dummyInfoProvider) Files() []string {
+	return nil
+}
+
 func (p dummyInfoProvider) GetSpecDirs() []string {
 	return []string{"specs"}
 }
 
+func (p dummyInfoProvider) Stop() {}
+
 func (p dummyInfoProvider) SearchConceptDictionary(stepValue string) *gauge.Concept {
 	return &(gauge.Concept{FileName: "concept_uri.cpt", ConceptStep: &gauge.Step{
 		Value:    "concept1",
@@ -158,7 +165,7 @@ func TestCompletion(t *testing.T) {
 	responses[gm.Message_StepNamesResponse] = &gm.StepNamesResponse{Steps: []string{}}
 	lRunner.runner = &runner.GrpcRunner{Client: &mockLspClient{responses: responses}, Timeout: time.Second * 30}
 
-	got, err := completion(&jsonrpc2.Request{Params: &p})
+	got, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err != nil {
 		t.Fatalf("Expected error == nil in Completion, got %s", err.Error())
@@ -204,7 +211,7 @@ func TestCompletionForLineWithText(t *testing.T) {
 	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: "uri"}, Position: position})
 	p := json.RawMessage(b)
 
-	got, err := completion(&jsonrpc2.Request{Params: &p})
+	got, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err != nil {
 		t.Fatalf("Expected error == nil in Completion, got %s", err.Error())
@@ -250,7 +257,7 @@ func TestCompletionInBetweenLine(t *testing.T) {
 	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: "uri"}, Position: position})
 	p := json.RawMessage(b)
 
-	got, err := completion(&jsonrpc2.Request{Params: &p})
+	got, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err != nil {
 		t.Fatalf("Expected error == nil in Completion, got %s", err.Error())
@@ -297,7 +304,7 @@ func TestCompletionInBetweenLineHavingParams(t *testing.T) {
 	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: "uri"}, Position: position})
 	p := json.RawMessage(b)
 
-	got, err := completion(&jsonrpc2.Request{Params: &p})
+	got, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err != nil {
 		t.Fatalf("Expected error == nil in Completion, got %s", err.Error())
@@ -344,7 +351,7 @@ func TestCompletionInBetweenLineHavingSpecialParams(t *testing.T) {
 	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: "uri"}, Position: position})
 	p := json.RawMessage(b)
 
-	got, err := completion(&jsonrpc2.Request{Params: &p})
+	got, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err != nil {
 		t.Fatalf("Expected error == nil in Completion, got %s", err.Error())
@@ -364,21 +371,23 @@ func TestParamCompletion(t *testing.T) {
 	want := completionList{IsIncomplete: false, Items: []completionItem{
 		{
 			CompletionItem: lsp.CompletionItem{
-				Label:      "hello",
-				FilterText: "hello\"",
+				Label:      "gauge",
+				FilterText: "gauge\"",
 				Detail:     "static",
 				Kind:       lsp.CIKVariable,
-				TextEdit:   &lsp.TextEdit{Range: lsp.Range{Start: wantStartPos, End: wantEndPos}, NewText: "hello\""},
+				SortText:   "0000",
+				TextEdit:   &lsp.TextEdit{Range: lsp.Range{Start: wantStartPos, End: wantEndPos}, NewText: "gauge\""},
 			},
 			InsertTextFormat: text,
 		},
 		{
 			CompletionItem: lsp.CompletionItem{
-				Label:      "gauge",
-				FilterText: "gauge\"",
+				Label:      "hello",
+				FilterText: "hello\"",
 				Detail:     "static",
 				Kind:       lsp.CIKVariable,
-				TextEdit:   &lsp.TextEdit{Range: lsp.Range{Start: wantStartPos, End: wantEndPos}, NewText: "gauge\""},
+				SortText:   "0001",
+				TextEdit:   &lsp.TextEdit{Range: lsp.Range{Start: wantStartPos, End: wantEndPos}, NewText: "hello\""},
 			},
 			InsertTextFormat: text,
 		},
@@ -389,7 +398,7 @@ func TestParamCompletion(t *testing.T) {
 	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: "uri"}, Position: position})
 	p := json.RawMessage(b)
 
-	got, err := completion(&jsonrpc2.Request{Params: &p})
+	got, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err != nil {
 		t.Fatalf("Expected error == nil in Completion, got %s", err.Error())
@@ -401,7 +410,7 @@ func TestParamCompletion(t *testing.T) {
 
 func TestCompletionWithError(t *testing.T) {
 	p := json.RawMessage("sfdf")
-	_, err := completion(&jsonrpc2.Request{Params: &p})
+	_, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err == nil {
 		t.Error("Expected error != nil in Completion, got nil")
@@ -418,7 +427,7 @@ func TestCompletionForInvalidPosition(t *testing.T) {
 	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: "uri"}, Position: position})
 	p := json.RawMessage(b)
 
-	got, err := completion(&jsonrpc2.Request{Params: &p})
+	got, err := completion(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err != nil {
 		t.Fatalf("Expected error == nil in Completion, got %s", err.Error())
@@ -40,7 +40,7 @@ type specInfo struct {
 }
 
 func specs() (interface{}, error) {
-	specDetails := provider.GetAvailableSpecDetails([]string{})
+	specDetails := provider.GetAvailableSpecDetails(provider.GetSpecDirs())
 	specs := make([]specInfo, 0)
 	for _, d := range specDetails {
 		specs = append(specs, specInfo{Heading: d.Spec.Heading.Value, ExecutionIdentifier: d.Spec.FileName})
@@ -38,28 +38,76 @@ func definition(req *jsonrpc2.Request) (interface{}, error) {
 		return nil, err
 	}
 
-	fileContent := getContent(params.TextDocument.URI)
-	if util.IsConcept(util.ConvertURItoFilePath(params.TextDocument.URI)) {
+	if step := stepAt(params.TextDocument.URI, params.Position.Line); step != nil {
+		return search(step)
+	}
+	return nil, nil
+}
+
+func implementation(req *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.TextDocumentPositionParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, err
+	}
+	step := stepAt(params.TextDocument.URI, params.Position.Line)
+	if step == nil || lRunner.runner == nil {
+		return nil, nil
+	}
+	var locations []lsp.Location
+	for _, stepValue := range leafSteps(step.Value, make(map[string]bool)) {
+		responseMessage, err := getStepNameResponse(stepValue)
+		if err != nil {
+			return nil, err
+		}
+		if responseMessage != nil && responseMessage.GetIsStepPresent() {
+			locations = append(locations, getLspLocationForStep(responseMessage.GetFileName(), responseMessage.GetSpan()))
+		}
+	}
+	return locations, nil
+}
+
+// leafSteps gives the distinct values of the steps a step expands to, in the order they are executed.
+// A step which is not a concept expands to itself.
+func leafSteps(stepValue string, visited map[string]bool) []string {
+	if visited[stepValue] {
+		return nil
+	}
+	visited[stepValue] = true
+	concept := provider.SearchConceptDictionary(stepValue)
+	if concept == nil {
+		return []string{stepValue}
+	}
+	var values []string
+	for _, step := range concept.ConceptStep.ConceptSteps {
+		values = append(values, leafSteps(step.Value, visited)...)
+	}
+	return values
+}
+
+// stepAt gives the step used at the given line of a spec or a concept file.
+func stepAt(uri lsp.DocumentURI, line int) *gauge.Step {
+	fileContent := getContent(uri)
+	if util.IsConcept(util.ConvertURItoFilePath(uri)) {
 		concepts, _ := new(parser.ConceptParser).Parse(fileContent, "")
 		for _, concept := range concepts {
 			for _, step := range concept.ConceptSteps {
-				if (step.LineNo - 1) == params.Position.Line {
-					return search(step)
+				if (step.LineNo - 1) == line {
+					return step
 				}
 			}
 		}
-	} else {
-		spec, _ := new(parser.SpecParser).ParseSpecText(fileContent, "")
-		for _, item := range spec.AllItems() {
-			if item.Kind() == gauge.StepKind {
-				step := item.(*gauge.Step)
-				if (step.LineNo - 1) == params.Position.Line {
-					return search(step)
-				}
+		return nil
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText(fileContent, "")
+	for _, item := range spec.AllItems() {
+		if item.Kind() == gauge.StepKind {
+			step := item.(*gauge.Step)
+			if (step.LineNo - 1) == line {
+				return step
 			}
 		}
 	}
-	return nil, nil
+	return nil
 }
 
 func search(step *gauge.Step) (interface{}, error) {
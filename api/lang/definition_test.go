@@ -19,8 +19,13 @@ package lang
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 	"github.com/sourcegraph/jsonrpc2"
@@ -68,3 +73,63 @@ func TestConceptDefinitionInConceptFile(t *testing.T) {
 		t.Errorf("Wrong definition found, got: `%v`, want: `%v`", got, want)
 	}
 }
+
+type conceptsInfoProvider struct {
+	dummyInfoProvider
+	concepts map[string]*gauge.Concept
+}
+
+func (p conceptsInfoProvider) SearchConceptDictionary(stepValue string) *gauge.Concept {
+	return p.concepts[stepValue]
+}
+
+func TestImplementationOfConceptUsageGivesLeafStepImplementations(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("uri.spec")
+	openFilesCache.add(uri, "# Specification\n## Scenario\n* login")
+
+	provider = conceptsInfoProvider{concepts: map[string]*gauge.Concept{
+		"login": {FileName: "login.cpt", ConceptStep: &gauge.Step{Value: "login", ConceptSteps: []*gauge.Step{
+			{Value: "open app"},
+			{Value: "enter credentials"},
+		}}},
+		"enter credentials": {FileName: "login.cpt", ConceptStep: &gauge.Step{Value: "enter credentials", ConceptSteps: []*gauge.Step{
+			{Value: "type user"},
+			{Value: "open app"},
+		}}},
+	}}
+	responses := map[gm.Message_MessageType]interface{}{}
+	responses[gm.Message_StepNameResponse] = &gm.StepNameResponse{
+		IsStepPresent: true,
+		FileName:      "step_impl.js",
+		Span:          &gm.Span{Start: 3, StartChar: 0, End: 5, EndChar: 2},
+	}
+	lRunner.runner = &runner.GrpcRunner{Client: &mockLspClient{responses: responses}, Timeout: time.Second * 30}
+
+	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}, Position: lsp.Position{Line: 2, Character: 3}})
+	p := json.RawMessage(b)
+	got, err := implementation(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+
+	location := lsp.Location{URI: util.ConvertPathToURI("step_impl.js"), Range: lsp.Range{
+		Start: lsp.Position{Line: 2, Character: 0},
+		End:   lsp.Position{Line: 4, Character: 2},
+	}}
+	want := []lsp.Location{location, location}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestLeafStepsOfAStepWhichIsNotAConcept(t *testing.T) {
+	provider = conceptsInfoProvider{}
+
+	got := leafSteps("say hello", make(map[string]bool))
+
+	want := []string{"say hello"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
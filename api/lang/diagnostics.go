@@ -20,11 +20,12 @@ package lang
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 
-	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/gauge"
 	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/lint"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/validation"
@@ -36,7 +37,8 @@ import (
 var diagnosticsLock sync.Mutex
 
 // isInQueue ensures that only one other goroutine waits for the diagnostic lock.
-// Since diagnostics are published for all files, multiple threads need not wait to publish diagnostics.
+// Since diagnostics are published for all files whose diagnostics have changed, multiple threads need not wait to
+// publish diagnostics.
 var isInQueue = false
 
 func publishDiagnostics(ctx context.Context, conn jsonrpc2.JSONRPC2) {
@@ -49,15 +51,37 @@ func publishDiagnostics(ctx context.Context, conn jsonrpc2.JSONRPC2) {
 
 		isInQueue = false
 
-		diagnosticsMap, err := getDiagnostics()
-		if err != nil {
-			logError(nil, "Unable to publish diagnostics, error : %s", err.Error())
-			return
-		}
-		for uri, diagnostics := range diagnosticsMap {
+		ctx = pending.start(ctx, diagnosticsComputation{})
+		defer pending.done(diagnosticsComputation{})
+		forEachWorkspace(func() {
+			publishWorkspaceDiagnostics(ctx, conn)
+		})
+	}
+}
+
+// publishWorkspaceDiagnostics publishes the diagnostics of the active workspace which have changed since they were
+// last published.
+func publishWorkspaceDiagnostics(ctx context.Context, conn jsonrpc2.JSONRPC2) {
+	diagnosticsMap, err := getDiagnostics(ctx)
+	if ctx.Err() != nil {
+		logDebug(nil, "Publishing diagnostics is cancelled.")
+		return
+	}
+	if err != nil {
+		logError(nil, "Unable to publish diagnostics, error : %s", err.Error())
+		return
+	}
+	for uri, diagnostics := range diagnosticsMap {
+		if !reflect.DeepEqual(cachedDiagnostics.published[uri], diagnostics) {
 			publishDiagnostic(uri, diagnostics, conn, ctx)
 		}
 	}
+	for uri := range cachedDiagnostics.published {
+		if _, ok := diagnosticsMap[uri]; !ok {
+			publishDiagnostic(uri, []lsp.Diagnostic{}, conn, ctx)
+		}
+	}
+	cachedDiagnostics.published = diagnosticsMap
 }
 
 func publishDiagnostic(uri lsp.DocumentURI, diagnostics []lsp.Diagnostic, conn jsonrpc2.JSONRPC2, ctx context.Context) {
@@ -65,22 +89,52 @@ func publishDiagnostic(uri lsp.DocumentURI, diagnostics []lsp.Diagnostic, conn j
 	conn.Notify(ctx, "textDocument/publishDiagnostics", params)
 }
 
-func getDiagnostics() (map[lsp.DocumentURI][]lsp.Diagnostic, error) {
-	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
-	conceptDictionary, err := validateConcepts(diagnostics)
+func getDiagnostics(ctx context.Context) (map[lsp.DocumentURI][]lsp.Diagnostic, error) {
+	return cachedDiagnostics.diagnostics(ctx)
+}
+
+// getLinter gives the linter configured for the project, or nil if the lint configuration cannot be read.
+func getLinter() *lint.Linter {
+	config, err := lint.LoadConfig()
 	if err != nil {
-		return nil, err
+		logDebug(nil, "Lint diagnostics are not published. %s", err.Error())
+		return nil
 	}
-	if err = validateSpecs(conceptDictionary, diagnostics); err != nil {
-		return nil, err
+	linter, err := lint.NewLinter(config)
+	if err != nil {
+		logError(nil, "Lint diagnostics are not published. %s", err.Error())
+		return nil
+	}
+	return linter
+}
+
+func createLintDiagnostics(issues []*lint.Issue, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
+	for _, issue := range issues {
+		uri := util.ConvertPathToURI(issue.File)
+		d := createDiagnostic(uri, issue.Message, issue.Line-1, lintSeverity(issue.Severity))
+		d.Source = issue.Rule
+		diagnostics[uri] = append(diagnostics[uri], d)
+	}
+}
+
+func lintSeverity(severity lint.Severity) lsp.DiagnosticSeverity {
+	switch severity {
+	case lint.Error:
+		return lsp.Error
+	case lint.Warning:
+		return lsp.Warning
 	}
-	return diagnostics, nil
+	return lsp.Information
 }
 
 func createValidationDiagnostics(errors []error, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
 	for _, err := range errors {
 		uri := util.ConvertPathToURI(err.(validation.StepValidationError).FileName())
-		d := createDiagnostic(uri, err.(validation.StepValidationError).Message(), err.(validation.StepValidationError).Step().LineNo-1, 1)
+		message := err.(validation.StepValidationError).Message()
+		if match := err.(validation.StepValidationError).ClosestMatch(); match != "" {
+			message = fmt.Sprintf("%s. Did you mean '%s'?", message, match)
+		}
+		d := createDiagnostic(uri, message, err.(validation.StepValidationError).Step().LineNo-1, 1)
 		if err.(validation.StepValidationError).ErrorType() == gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
 			d.Code = err.(validation.StepValidationError).Suggestion()
 		}
@@ -89,71 +143,29 @@ func createValidationDiagnostics(errors []error, diagnostics map[lsp.DocumentURI
 	return
 }
 
-func validateSpecifications(specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary) []error {
+func validateSpecifications(ctx context.Context, specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary, stepValidations map[string]error) ([]error, error) {
 	if lRunner.runner == nil {
-		return []error{}
+		return []error{}, nil
 	}
-	vErrs := validation.NewValidator(specs, lRunner.runner, conceptDictionary).Validate()
-	return validation.FilterDuplicates(vErrs)
-}
-
-func validateSpecs(conceptDictionary *gauge.ConceptDictionary, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) error {
-	specFiles := util.GetSpecFiles(util.GetSpecDirs())
-	specs := make([]*gauge.Specification, 0)
-	for _, specFile := range specFiles {
-		uri := util.ConvertPathToURI(specFile)
-		if _, ok := diagnostics[uri]; !ok {
-			diagnostics[uri] = make([]lsp.Diagnostic, 0)
-		}
-		content, err := getContentFromFileOrDisk(specFile)
-		if err != nil {
-			return fmt.Errorf("Unable to read file %s", err)
-		}
-		spec, res, err := new(parser.SpecParser).Parse(content, conceptDictionary, specFile)
-		if err != nil {
-			return err
-		}
-		createDiagnostics(res, diagnostics)
-		if res.Ok {
-			specs = append(specs, spec)
-		}
-	}
-	createValidationDiagnostics(validateSpecifications(specs, conceptDictionary), diagnostics)
-	return nil
-}
-
-func validateConcepts(diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) (*gauge.ConceptDictionary, error) {
-	conceptFiles := util.GetConceptFiles()
-	conceptDictionary := gauge.NewConceptDictionary()
-	for _, conceptFile := range conceptFiles {
-		uri := util.ConvertPathToURI(conceptFile)
-		if _, ok := diagnostics[uri]; !ok {
-			diagnostics[uri] = make([]lsp.Diagnostic, 0)
-		}
-		content, err := getContentFromFileOrDisk(conceptFile)
-		if err != nil {
-			return nil, fmt.Errorf("Unable to read file %s", err)
-		}
-		cpts, pRes := new(parser.ConceptParser).Parse(content, conceptFile)
-		pErrs, err := parser.AddConcept(cpts, conceptFile, conceptDictionary)
-		if err != nil {
-			return nil, err
-		}
-		pRes.ParseErrors = append(pRes.ParseErrors, pErrs...)
-		createDiagnostics(pRes, diagnostics)
+	vErrs, err := validation.NewValidatorWithCache(specs, lRunner.runner, conceptDictionary, stepValidations).ValidateContext(ctx)
+	if err != nil {
+		return nil, err
 	}
-	createDiagnostics(parser.ValidateConcepts(conceptDictionary), diagnostics)
-	return conceptDictionary, nil
+	return validation.FilterDuplicates(vErrs), nil
 }
 
 func createDiagnostics(res *parser.ParseResult, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
 	for _, err := range res.ParseErrors {
 		uri := util.ConvertPathToURI(err.FileName)
-		diagnostics[uri] = append(diagnostics[uri], createDiagnostic(uri, err.Message, err.LineNo-1, 1))
+		d := createDiagnostic(uri, err.Message, err.LineNo-1, 1)
+		d.Code = string(err.Code)
+		diagnostics[uri] = append(diagnostics[uri], d)
 	}
 	for _, warning := range res.Warnings {
 		uri := util.ConvertPathToURI(warning.FileName)
-		diagnostics[uri] = append(diagnostics[uri], createDiagnostic(uri, warning.Message, warning.LineNo-1, 2))
+		d := createDiagnostic(uri, warning.Message, warning.LineNo-1, 2)
+		d.Code = string(warning.Code)
+		diagnostics[uri] = append(diagnostics[uri], d)
 	}
 }
 
@@ -171,12 +183,3 @@ func createDiagnostic(uri lsp.DocumentURI, message string, line int, severity ls
 		Severity: severity,
 	}
 }
-
-func getContentFromFileOrDisk(fileName string) (string, error) {
-	uri := util.ConvertPathToURI(fileName)
-	if isOpen(uri) {
-		return getContent(uri), nil
-	} else {
-		return common.ReadFileContents(fileName)
-	}
-}
@@ -0,0 +1,322 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/lint"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+// diagnosticsCache keeps the parse result of every spec and concept file and the validation result of every step
+// value, so that only the files affected by a change are parsed and validated again.
+type diagnosticsCache struct {
+	concepts         map[string]*conceptFileResult
+	specs            map[string]*specFileResult
+	dictionary       *gauge.ConceptDictionary
+	dictionaryResult *parser.ParseResult
+	linter           *lint.Linter
+	conceptIssues    []*lint.Issue
+	stepValidations  map[string]error
+	published        map[lsp.DocumentURI][]lsp.Diagnostic
+
+	// changes made outside the editor, which are noted by the request handlers while diagnostics are computed.
+	changesLock           sync.Mutex
+	changedOnDisk         map[string]bool
+	implementationChanged bool
+}
+
+type conceptFileResult struct {
+	content  string
+	concepts []*gauge.Step
+	result   *parser.ParseResult
+	defines  map[string]bool
+	uses     map[string]bool
+}
+
+type specFileResult struct {
+	content string
+	spec    *gauge.Specification
+	result  *parser.ParseResult
+	issues  []*lint.Issue
+	uses    map[string]bool
+}
+
+var cachedDiagnostics = newDiagnosticsCache()
+
+func newDiagnosticsCache() *diagnosticsCache {
+	return &diagnosticsCache{
+		concepts:        make(map[string]*conceptFileResult),
+		specs:           make(map[string]*specFileResult),
+		stepValidations: make(map[string]error),
+		published:       make(map[lsp.DocumentURI][]lsp.Diagnostic),
+		changedOnDisk:   make(map[string]bool),
+	}
+}
+
+// fileChanged notes that a spec or concept file has to be read again from the disk.
+func (c *diagnosticsCache) fileChanged(uri lsp.DocumentURI) {
+	c.changesLock.Lock()
+	defer c.changesLock.Unlock()
+	c.changedOnDisk[util.ConvertURItoFilePath(uri)] = true
+}
+
+// stepImplementationChanged notes that the steps have to be validated again with the runner.
+func (c *diagnosticsCache) stepImplementationChanged() {
+	c.changesLock.Lock()
+	defer c.changesLock.Unlock()
+	c.implementationChanged = true
+}
+
+func (c *diagnosticsCache) takeChanges() map[string]bool {
+	c.changesLock.Lock()
+	defer c.changesLock.Unlock()
+	changed := c.changedOnDisk
+	c.changedOnDisk = make(map[string]bool)
+	if c.implementationChanged {
+		c.stepValidations = make(map[string]error)
+		c.implementationChanged = false
+	}
+	return changed
+}
+
+func (c *diagnosticsCache) diagnostics(ctx context.Context) (map[lsp.DocumentURI][]lsp.Diagnostic, error) {
+	changedOnDisk := c.takeChanges()
+	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
+	linter := getLinter()
+	relint := !reflect.DeepEqual(linter, c.linter)
+	c.linter = linter
+	dictionary, affected, err := c.validateConcepts(diagnostics, changedOnDisk)
+	if err != nil {
+		return nil, err
+	}
+	if affected != nil {
+		// The closest match of an unimplemented step can be a concept, so the steps are validated again.
+		c.stepValidations = make(map[string]error)
+	}
+	if linter == nil {
+		c.conceptIssues = nil
+	} else if relint || affected != nil {
+		c.conceptIssues = linter.Concepts(dictionary)
+	}
+	createLintDiagnostics(c.conceptIssues, diagnostics)
+	if err = c.validateSpecs(ctx, dictionary, affected, relint, diagnostics, changedOnDisk); err != nil {
+		return nil, err
+	}
+	return diagnostics, nil
+}
+
+// content gives the content of the file in the editor. A file which is not open is read from the disk only if it is not
+// cached or has changed on the disk.
+func content(file string, cached *string, changedOnDisk map[string]bool) (string, error) {
+	uri := util.ConvertPathToURI(file)
+	if isOpen(uri) {
+		return getContent(uri), nil
+	}
+	if cached != nil && !changedOnDisk[file] {
+		return *cached, nil
+	}
+	return common.ReadFileContents(file)
+}
+
+// validateConcepts parses the changed concept files and the concept files which use the concepts defined in them. It
+// gives the step values whose concept definitions have changed, or nil if no concept has changed.
+func (c *diagnosticsCache) validateConcepts(diagnostics map[lsp.DocumentURI][]lsp.Diagnostic, changedOnDisk map[string]bool) (*gauge.ConceptDictionary, map[string]bool, error) {
+	conceptFiles := util.GetConceptFiles()
+	files := make(map[string]*conceptFileResult)
+	parsed := make(map[string]bool)
+	affected := make(map[string]bool)
+	for _, conceptFile := range conceptFiles {
+		var cachedContent *string
+		cached, ok := c.concepts[conceptFile]
+		if ok {
+			cachedContent = &cached.content
+		}
+		text, err := content(conceptFile, cachedContent, changedOnDisk)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Unable to read file %s", err)
+		}
+		if ok && cached.content == text {
+			files[conceptFile] = cached
+			continue
+		}
+		if ok {
+			addAll(affected, cached.defines)
+		}
+		files[conceptFile] = parseConceptFile(conceptFile, text)
+		addAll(affected, files[conceptFile].defines)
+		parsed[conceptFile] = true
+	}
+	for conceptFile, cached := range c.concepts {
+		if _, ok := files[conceptFile]; !ok {
+			addAll(affected, cached.defines)
+			parsed[conceptFile] = true
+		}
+	}
+	c.concepts = files
+	if len(parsed) == 0 && c.dictionary != nil {
+		c.createConceptDiagnostics(conceptFiles, diagnostics)
+		return c.dictionary, nil, nil
+	}
+	// The steps of a concept are changed when the concepts are added to the dictionary, so the concepts which use a
+	// changed concept are parsed again.
+	for reparsed := true; reparsed; {
+		reparsed = false
+		for _, conceptFile := range conceptFiles {
+			if !parsed[conceptFile] && containsAny(files[conceptFile].uses, affected) {
+				files[conceptFile] = parseConceptFile(conceptFile, files[conceptFile].content)
+				addAll(affected, files[conceptFile].defines)
+				parsed[conceptFile] = true
+				reparsed = true
+			}
+		}
+	}
+	dictionary := gauge.NewConceptDictionary()
+	result := &parser.ParseResult{ParseErrors: []parser.ParseError{}}
+	for _, conceptFile := range conceptFiles {
+		pErrs, err := parser.AddConcept(files[conceptFile].concepts, conceptFile, dictionary)
+		if err != nil {
+			return nil, nil, err
+		}
+		result.ParseErrors = append(result.ParseErrors, pErrs...)
+	}
+	vRes := parser.ValidateConcepts(dictionary)
+	result.ParseErrors = append(result.ParseErrors, vRes.ParseErrors...)
+	c.dictionary, c.dictionaryResult = dictionary, result
+	c.createConceptDiagnostics(conceptFiles, diagnostics)
+	// Validation removes the steps of the concepts with circular references, so these files are parsed again next time.
+	for _, err := range vRes.ParseErrors {
+		delete(c.concepts, err.FileName)
+	}
+	return dictionary, affected, nil
+}
+
+func (c *diagnosticsCache) createConceptDiagnostics(conceptFiles []string, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
+	for _, conceptFile := range conceptFiles {
+		uri := util.ConvertPathToURI(conceptFile)
+		if _, ok := diagnostics[uri]; !ok {
+			diagnostics[uri] = make([]lsp.Diagnostic, 0)
+		}
+		if f, ok := c.concepts[conceptFile]; ok {
+			createDiagnostics(f.result, diagnostics)
+		}
+	}
+	createDiagnostics(c.dictionaryResult, diagnostics)
+}
+
+func parseConceptFile(conceptFile, text string) *conceptFileResult {
+	concepts, res := new(parser.ConceptParser).Parse(text, conceptFile)
+	res.Warnings = append(res.Warnings, parser.TableCellCountWarnings(text, conceptFile)...)
+	f := &conceptFileResult{content: text, concepts: concepts, result: res, defines: make(map[string]bool), uses: make(map[string]bool)}
+	for _, concept := range concepts {
+		f.defines[concept.Value] = true
+		for _, step := range concept.ConceptSteps {
+			f.uses[step.Value] = true
+		}
+	}
+	return f
+}
+
+// validateSpecs parses the changed spec files and the spec files which use a changed concept, and validates the steps
+// which have not been validated before. Validation stops once the context is done.
+func (c *diagnosticsCache) validateSpecs(ctx context.Context, dictionary *gauge.ConceptDictionary, affected map[string]bool, relint bool, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic, changedOnDisk map[string]bool) error {
+	specFiles := util.GetSpecFiles(provider.GetSpecDirs())
+	files := make(map[string]*specFileResult)
+	specs := make([]*gauge.Specification, 0)
+	for _, specFile := range specFiles {
+		uri := util.ConvertPathToURI(specFile)
+		if _, ok := diagnostics[uri]; !ok {
+			diagnostics[uri] = make([]lsp.Diagnostic, 0)
+		}
+		var cachedContent *string
+		f, ok := c.specs[specFile]
+		if ok {
+			cachedContent = &f.content
+		}
+		text, err := content(specFile, cachedContent, changedOnDisk)
+		if err != nil {
+			return fmt.Errorf("Unable to read file %s", err)
+		}
+		if !ok || f.content != text || containsAny(f.uses, affected) {
+			if f, err = parseSpecFile(specFile, text, dictionary); err != nil {
+				return err
+			}
+			relintSpec(f, c.linter)
+		} else if relint {
+			relintSpec(f, c.linter)
+		}
+		files[specFile] = f
+		createDiagnostics(f.result, diagnostics)
+		if f.result.Ok {
+			specs = append(specs, f.spec)
+			createLintDiagnostics(f.issues, diagnostics)
+		}
+	}
+	c.specs = files
+	errs, err := validateSpecifications(ctx, specs, dictionary, c.stepValidations)
+	if err != nil {
+		return err
+	}
+	createValidationDiagnostics(errs, diagnostics)
+	return nil
+}
+
+func parseSpecFile(specFile, text string, dictionary *gauge.ConceptDictionary) (*specFileResult, error) {
+	spec, res, err := new(parser.SpecParser).Parse(text, dictionary, specFile)
+	if err != nil {
+		return nil, err
+	}
+	res.Warnings = append(res.Warnings, parser.TableCellCountWarnings(text, specFile)...)
+	f := &specFileResult{content: text, spec: spec, result: res, uses: make(map[string]bool)}
+	if spec != nil {
+		for _, step := range spec.Steps() {
+			f.uses[step.Value] = true
+		}
+	}
+	return f, nil
+}
+
+func relintSpec(f *specFileResult, linter *lint.Linter) {
+	f.issues = nil
+	if linter != nil && f.result.Ok {
+		f.issues = linter.Spec(f.spec, f.content)
+	}
+}
+
+func addAll(to, from map[string]bool) {
+	for value := range from {
+		to[value] = true
+	}
+}
+
+func containsAny(values, of map[string]bool) bool {
+	for value := range values {
+		if of[value] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type publishedConn struct {
+	MockConn
+	uris []lsp.DocumentURI
+}
+
+func (conn *publishedConn) Notify(ctx context.Context, method string, params interface{}, opt ...jsonrpc2.CallOption) error {
+	conn.uris = append(conn.uris, params.(lsp.PublishDiagnosticsParams).URI)
+	return nil
+}
+
+func TestDiagnosticsParsesOnlyAffectedSpecs(t *testing.T) {
+	setup()
+	otherSpecFile := "bar.spec"
+	util.GetSpecFiles = func(paths []string) []string {
+		return []string{specFile, otherSpecFile}
+	}
+	openFilesCache.add(util.ConvertPathToURI(conceptFile), "# concept\n* foo\n")
+	openFilesCache.add(util.ConvertPathToURI(specFile), "# Spec\n## Scenario\n* concept\n")
+	openFilesCache.add(util.ConvertPathToURI(otherSpecFile), "# Other spec\n## Scenario\n* step\n")
+	if _, err := getDiagnostics(context.Background()); err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+	spec, otherSpec := cachedDiagnostics.specs[specFile].spec, cachedDiagnostics.specs[otherSpecFile].spec
+
+	openFilesCache.add(util.ConvertPathToURI(otherSpecFile), "# Other spec\n## Scenario\n* another step\n")
+	if _, err := getDiagnostics(context.Background()); err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+	if cachedDiagnostics.specs[specFile].spec != spec {
+		t.Errorf("expected %s not to be parsed again", specFile)
+	}
+	if cachedDiagnostics.specs[otherSpecFile].spec == otherSpec {
+		t.Errorf("expected %s to be parsed again", otherSpecFile)
+	}
+	otherSpec = cachedDiagnostics.specs[otherSpecFile].spec
+
+	openFilesCache.add(util.ConvertPathToURI(conceptFile), "# concept\n* bar\n")
+	if _, err := getDiagnostics(context.Background()); err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+	if cachedDiagnostics.specs[specFile].spec == spec {
+		t.Errorf("expected %s to be parsed again", specFile)
+	}
+	if cachedDiagnostics.specs[otherSpecFile].spec != otherSpec {
+		t.Errorf("expected %s not to be parsed again", otherSpecFile)
+	}
+	if got := cachedDiagnostics.specs[specFile].spec.Scenarios[0].Steps[0].ConceptSteps[0].Value; got != "bar" {
+		t.Errorf("want concept step `bar`, got `%s`", got)
+	}
+}
+
+func TestDiagnosticsRemembersStepValidationTillImplementationChanges(t *testing.T) {
+	setup()
+	responses := map[gauge_messages.Message_MessageType]interface{}{}
+	responses[gauge_messages.Message_StepValidateResponse] = &gauge_messages.StepValidateResponse{IsValid: false, ErrorType: gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND}
+	responses[gauge_messages.Message_StepNamesResponse] = &gauge_messages.StepNamesResponse{}
+	lRunner.runner.Client = &mockLspClient{responses: responses}
+	uri := util.ConvertPathToURI(specFile)
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* step\n")
+
+	d, err := getDiagnostics(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+	if len(d[uri]) != 1 {
+		t.Fatalf("expected a validation error, got: %+v", d[uri])
+	}
+
+	responses[gauge_messages.Message_StepValidateResponse] = &gauge_messages.StepValidateResponse{IsValid: true}
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* step\n\n")
+	d, _ = getDiagnostics(context.Background())
+	if len(d[uri]) != 1 {
+		t.Errorf("expected the validation of the step to be remembered, got: %+v", d[uri])
+	}
+
+	cachedDiagnostics.stepImplementationChanged()
+	d, _ = getDiagnostics(context.Background())
+	if len(d[uri]) != 0 {
+		t.Errorf("expected the step to be validated again, got: %+v", d[uri])
+	}
+}
+
+func TestDiagnosticOfUnimplementedStepSuggestsClosestStep(t *testing.T) {
+	setup()
+	responses := map[gauge_messages.Message_MessageType]interface{}{}
+	responses[gauge_messages.Message_StepValidateResponse] = &gauge_messages.StepValidateResponse{IsValid: false, ErrorType: gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND}
+	responses[gauge_messages.Message_StepNamesResponse] = &gauge_messages.StepNamesResponse{Steps: []string{"Log in as user <name>"}}
+	lRunner.runner.Client = &mockLspClient{responses: responses}
+	uri := util.ConvertPathToURI(specFile)
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* Login as user \"john\"\n")
+
+	d, err := getDiagnostics(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+
+	want := "Step implementation not found. Did you mean 'Log in as user <name>'?"
+	if len(d[uri]) != 1 || d[uri][0].Message != want {
+		t.Errorf("want: `%s`,\n got: `%+v`", want, d[uri])
+	}
+}
+
+func TestDiagnosticOfUnimplementedStepSuggestsConceptAddedLater(t *testing.T) {
+	setup()
+	responses := map[gauge_messages.Message_MessageType]interface{}{}
+	responses[gauge_messages.Message_StepValidateResponse] = &gauge_messages.StepValidateResponse{IsValid: false, ErrorType: gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND}
+	responses[gauge_messages.Message_StepNamesResponse] = &gauge_messages.StepNamesResponse{}
+	lRunner.runner.Client = &mockLspClient{responses: responses}
+	uri := util.ConvertPathToURI(specFile)
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* Login as user \"john\"\n")
+	openFilesCache.add(util.ConvertPathToURI(conceptFile), "# concept\n* foo\n")
+
+	d, err := getDiagnostics(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+	if len(d[uri]) != 1 || d[uri][0].Message != "Step implementation not found" {
+		t.Fatalf("expected a validation error without a suggestion, got: %+v", d[uri])
+	}
+
+	openFilesCache.add(util.ConvertPathToURI(conceptFile), "# Log in as user <name>\n* foo\n")
+	d, _ = getDiagnostics(context.Background())
+
+	want := "Step implementation not found. Did you mean 'Log in as user <name>'?"
+	if len(d[uri]) != 1 || d[uri][0].Message != want {
+		t.Errorf("want: `%s`,\n got: `%+v`", want, d[uri])
+	}
+}
+
+func TestPublishDiagnosticsOnlyForChangedFiles(t *testing.T) {
+	setup()
+	uri := util.ConvertPathToURI(specFile)
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* step\n")
+	openFilesCache.add(util.ConvertPathToURI(conceptFile), "# concept\n* foo\n")
+
+	conn := &publishedConn{}
+	publishDiagnostics(context.Background(), conn)
+	if len(conn.uris) != 2 {
+		t.Errorf("expected diagnostics to be published for all files, got: %v", conn.uris)
+	}
+
+	conn.uris = nil
+	publishDiagnostics(context.Background(), conn)
+	if len(conn.uris) != 0 {
+		t.Errorf("expected no diagnostics to be published, got: %v", conn.uris)
+	}
+
+	openFilesCache.add(uri, "# Spec\n## Scenario\n")
+	publishDiagnostics(context.Background(), conn)
+	if len(conn.uris) != 1 || conn.uris[0] != uri {
+		t.Errorf("expected diagnostics to be published only for %s, got: %v", uri, conn.uris)
+	}
+}
@@ -18,7 +18,10 @@
 package lang
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -26,7 +29,9 @@ import (
 
 	"strings"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
@@ -42,6 +47,7 @@ func TestMain(m *testing.M) {
 }
 
 func setup() {
+	cachedDiagnostics = newDiagnosticsCache()
 	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
 	openFilesCache.add(util.ConvertPathToURI(conceptFile), "")
 	openFilesCache.add(util.ConvertPathToURI(specFile), "")
@@ -85,7 +91,7 @@ Scenario Heading
 		},
 		{
 			Range: lsp.Range{
-				Start: lsp.Position{3, 0},
+				Start: lsp.Position{Line: 3, Character: 0},
 				End:   lsp.Position{3, 16},
 			},
 			Message:  "Multiple spec headings found in same file",
@@ -93,7 +99,88 @@ Scenario Heading
 		},
 	}
 
-	diagnostics, err := getDiagnostics()
+	diagnostics, err := getDiagnostics(context.Background())
+	if err != nil {
+		t.Errorf("Expected no error, got : %s", err.Error())
+	}
+
+	got := diagnostics[uri]
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestDiagnosticOfParseErrorHasErrorCode(t *testing.T) {
+	setup()
+	specText := `Specification Heading
+=====================
+
+Scenario Heading
+----------------
+
+* Step text
+
+scenario heading
+----------------
+
+* Step text`
+
+	uri := util.ConvertPathToURI(specFile)
+	openFilesCache.add(uri, specText)
+
+	want := []lsp.Diagnostic{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 8, Character: 0},
+				End:   lsp.Position{Line: 8, Character: 16},
+			},
+			Message:  "Duplicate scenario definition 'Scenario Heading' found in the same specification",
+			Severity: 1,
+			Code:     string(parser.DuplicateScenario),
+		},
+	}
+
+	diagnostics, err := getDiagnostics(context.Background())
+	if err != nil {
+		t.Errorf("Expected no error, got : %s", err.Error())
+	}
+
+	got := diagnostics[uri]
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestDiagnosticOfTableRowWithWrongNumberOfCells(t *testing.T) {
+	setup()
+	specText := `Specification Heading
+=====================
+
+|name|id|
+|----|--|
+|john|
+
+Scenario Heading
+----------------
+
+* Step text`
+
+	uri := util.ConvertPathToURI(specFile)
+	openFilesCache.add(uri, specText)
+
+	want := []lsp.Diagnostic{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 5, Character: 0},
+				End:   lsp.Position{Line: 5, Character: 6},
+			},
+			Message:  "Table row has 1 cell(s), expected 2",
+			Severity: 2,
+			Code:     string(parser.TableRowCellCountMismatch),
+		},
+	}
+
+	diagnostics, err := getDiagnostics(context.Background())
 	if err != nil {
 		t.Errorf("Expected no error, got : %s", err.Error())
 	}
@@ -116,7 +203,7 @@ Scenario Heading
 `
 	uri := util.ConvertPathToURI(specFile)
 	openFilesCache.add(uri, specText)
-	d, err := getDiagnostics()
+	d, err := getDiagnostics(context.Background())
 	if err != nil {
 		t.Errorf("expected no error.\n Got: %s", err.Error())
 	}
@@ -135,7 +222,7 @@ func TestParseConcept(t *testing.T) {
 
 	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
 
-	dictionary, err := validateConcepts(diagnostics)
+	dictionary, _, err := cachedDiagnostics.validateConcepts(diagnostics, nil)
 	if err != nil {
 		t.Errorf("expected no error.\n Got: %s", err.Error())
 	}
@@ -159,7 +246,7 @@ func TestDiagnosticsForConceptParseErrors(t *testing.T) {
 
 	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
 
-	validateConcepts(diagnostics)
+	cachedDiagnostics.validateConcepts(diagnostics, nil)
 	if len(diagnostics[uri]) <= 0 {
 		t.Errorf("expected parse errors")
 	}
@@ -188,7 +275,7 @@ func TestDiagnosticOfConceptsWithCircularReference(t *testing.T) {
 	uri := util.ConvertPathToURI(conceptFile)
 	openFilesCache.add(uri, cptText)
 
-	diagnostics, err := getDiagnostics()
+	diagnostics, err := getDiagnostics(context.Background())
 	if err != nil {
 		t.Errorf("expected no error.\n Got: %s", err.Error())
 	}
@@ -197,6 +284,49 @@ func TestDiagnosticOfConceptsWithCircularReference(t *testing.T) {
 	containsDiagnostics(got, 1, 0, "Circular reference found in concept.", t)
 }
 
+func TestDiagnosticsForLintIssues(t *testing.T) {
+	setup()
+	dir, err := ioutil.TempDir("", "lint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	manifest := `{"Language": "java", "Lint": {"rules": {"long-step": {"max": 2, "severity": "error"}}}}`
+	if err := ioutil.WriteFile(filepath.Join(dir, "manifest.json"), []byte(manifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	specText := `# Specification Heading
+
+## Scenario Heading
+* Step with long text
+`
+	uri := util.ConvertPathToURI(specFile)
+	openFilesCache.add(uri, specText)
+
+	diagnostics, err := getDiagnostics(context.Background())
+	if err != nil {
+		t.Errorf("expected no error.\n Got: %s", err.Error())
+	}
+
+	want := []lsp.Diagnostic{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 3, Character: 0},
+				End:   lsp.Position{Line: 3, Character: 21},
+			},
+			Message:  "Step has 4 words, more than 2",
+			Severity: lsp.Error,
+			Source:   "long-step",
+		},
+	}
+	if !reflect.DeepEqual(diagnostics[uri], want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, diagnostics[uri])
+	}
+}
+
 var containsDiagnostics = func(diagnostics []lsp.Diagnostic, line1, line2 int, startMessage string, t *testing.T) {
 	for _, diagnostic := range diagnostics {
 		if !strings.Contains(diagnostic.Message, startMessage) {
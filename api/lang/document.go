@@ -38,6 +38,7 @@ func documentOpened(req *jsonrpc2.Request, ctx context.Context, conn jsonrpc2.JS
 		openFile(params)
 	} else if lRunner.runner != nil {
 		err = cacheFileOnRunner(params.TextDocument.URI, params.TextDocument.Text, false, gm.CacheFileRequest_OPENED)
+		cachedDiagnostics.stepImplementationChanged()
 	}
 	go publishDiagnostics(ctx, conn)
 	return err
@@ -54,6 +55,7 @@ func documentChange(req *jsonrpc2.Request, ctx context.Context, conn jsonrpc2.JS
 		changeFile(params)
 	} else if lRunner.runner != nil {
 		err = cacheFileOnRunner(params.TextDocument.URI, params.ContentChanges[0].Text, false, gm.CacheFileRequest_CHANGED)
+		cachedDiagnostics.stepImplementationChanged()
 	}
 	go publishDiagnostics(ctx, conn)
 	return err
@@ -67,8 +69,10 @@ func documentClosed(req *jsonrpc2.Request, ctx context.Context, conn jsonrpc2.JS
 	}
 	if util.IsGaugeFile(string(params.TextDocument.URI)) {
 		closeFile(params)
+		cachedDiagnostics.fileChanged(params.TextDocument.URI)
 	} else if lRunner.runner != nil {
 		err = cacheFileOnRunner(params.TextDocument.URI, "", true, gm.CacheFileRequest_CLOSED)
+		cachedDiagnostics.stepImplementationChanged()
 	}
 	go publishDiagnostics(ctx, conn)
 	return err
@@ -80,6 +84,7 @@ func documentChangeWatchedFiles(req *jsonrpc2.Request, ctx context.Context, conn
 		return fmt.Errorf("failed to parse request. %s", err.Error())
 	}
 	for _, fileEvent := range params.Changes {
+		activateWorkspaceOf(fileEvent.URI)
 		if fileEvent.Type == int(lsp.Created) {
 			if err := documentCreate(fileEvent.URI, ctx, conn); err != nil {
 				return err
@@ -103,7 +108,10 @@ func documentCreate(uri lsp.DocumentURI, ctx context.Context, conn jsonrpc2.JSON
 	if !util.IsGaugeFile(string(uri)) {
 		if lRunner.runner != nil {
 			err = cacheFileOnRunner(uri, "", false, gm.CacheFileRequest_CREATED)
+			cachedDiagnostics.stepImplementationChanged()
 		}
+	} else {
+		cachedDiagnostics.fileChanged(uri)
 	}
 	return err
 }
@@ -113,8 +121,10 @@ func documentDelete(uri lsp.DocumentURI, ctx context.Context, conn jsonrpc2.JSON
 	if !util.IsGaugeFile(string(uri)) {
 		if lRunner.runner != nil {
 			err = cacheFileOnRunner(uri, "", false, gm.CacheFileRequest_DELETED)
+			cachedDiagnostics.stepImplementationChanged()
 		}
 	} else {
+		cachedDiagnostics.fileChanged(uri)
 		publishDiagnostic(uri, []lsp.Diagnostic{}, conn, ctx)
 	}
 	return err
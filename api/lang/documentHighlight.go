@@ -0,0 +1,93 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func documentHighlight(req *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.TextDocumentPositionParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, err
+	}
+	logDebug(req, "LangServer: request received : Type: Document Highlight URI: %s", params.TextDocument.URI)
+	uri := params.TextDocument.URI
+	line := getLine(uri, params.Position.Line)
+	for _, index := range paramPattern.FindAllStringSubmatchIndex(line, -1) {
+		if index[0] <= params.Position.Character && params.Position.Character < index[1] {
+			return paramHighlights(uri, line[index[2]:index[3]]), nil
+		}
+	}
+	step := stepAt(uri, params.Position.Line)
+	if step == nil {
+		return nil, nil
+	}
+	var highlights []lsp.DocumentHighlight
+	for _, s := range stepsIn(uri) {
+		if s.Value == step.Value {
+			highlights = append(highlights, lsp.DocumentHighlight{Range: *stepRange(uri, s.LineNo-1), Kind: int(lsp.Text)})
+		}
+	}
+	return highlights, nil
+}
+
+func paramHighlights(uri lsp.DocumentURI, name string) []lsp.DocumentHighlight {
+	var highlights []lsp.DocumentHighlight
+	for lineNo, line := range strings.Split(getContent(uri), "\n") {
+		for _, index := range paramPattern.FindAllStringSubmatchIndex(line, -1) {
+			if line[index[2]:index[3]] != name {
+				continue
+			}
+			highlights = append(highlights, lsp.DocumentHighlight{
+				Range: lsp.Range{
+					Start: lsp.Position{Line: lineNo, Character: index[0]},
+					End:   lsp.Position{Line: lineNo, Character: index[1]},
+				},
+				Kind: int(lsp.Text),
+			})
+		}
+	}
+	return highlights
+}
+
+// stepsIn gives the steps used in a spec or a concept file.
+func stepsIn(uri lsp.DocumentURI) []*gauge.Step {
+	var steps []*gauge.Step
+	if util.IsConcept(util.ConvertURItoFilePath(uri)) {
+		concepts, _ := new(parser.ConceptParser).Parse(getContent(uri), "")
+		for _, concept := range concepts {
+			steps = append(steps, concept.ConceptSteps...)
+		}
+		return steps
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText(getContent(uri), "")
+	for _, item := range spec.AllItems() {
+		if item.Kind() == gauge.StepKind {
+			steps = append(steps, item.(*gauge.Step))
+		}
+	}
+	return steps
+}
@@ -0,0 +1,96 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const highlightSpecText = `# Spec
+
+|name|
+|----|
+|foo |
+
+## Scenario
+* say <name> to "bar"
+* wait
+* say <name> to "baz"
+* say "foo" to "bar"
+`
+
+func getHighlights(t *testing.T, uri lsp.DocumentURI, position lsp.Position) interface{} {
+	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}, Position: position})
+	p := json.RawMessage(b)
+	got, err := documentHighlight(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	return got
+}
+
+func TestDocumentHighlightForStep(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, highlightSpecText)
+
+	got := getHighlights(t, uri, lsp.Position{Line: 7, Character: 2})
+
+	want := []lsp.DocumentHighlight{
+		{Range: lsp.Range{Start: lsp.Position{Line: 7, Character: 0}, End: lsp.Position{Line: 7, Character: 21}}, Kind: int(lsp.Text)},
+		{Range: lsp.Range{Start: lsp.Position{Line: 9, Character: 0}, End: lsp.Position{Line: 9, Character: 21}}, Kind: int(lsp.Text)},
+		{Range: lsp.Range{Start: lsp.Position{Line: 10, Character: 0}, End: lsp.Position{Line: 10, Character: 20}}, Kind: int(lsp.Text)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestDocumentHighlightForParam(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, highlightSpecText)
+
+	got := getHighlights(t, uri, lsp.Position{Line: 9, Character: 8})
+
+	want := []lsp.DocumentHighlight{
+		{Range: lsp.Range{Start: lsp.Position{Line: 7, Character: 6}, End: lsp.Position{Line: 7, Character: 12}}, Kind: int(lsp.Text)},
+		{Range: lsp.Range{Start: lsp.Position{Line: 9, Character: 6}, End: lsp.Position{Line: 9, Character: 12}}, Kind: int(lsp.Text)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestDocumentHighlightOutsideStep(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, highlightSpecText)
+
+	got := getHighlights(t, uri, lsp.Position{Line: 0, Character: 2})
+
+	if got != nil {
+		t.Errorf("want: `nil`,\n got: `%v`", got)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type foldingRangeKind string
+
+const (
+	commentFold foldingRangeKind = "comment"
+	regionFold  foldingRangeKind = "region"
+)
+
+type foldingRangeParams struct {
+	TextDocument lsp.TextDocumentIdentifier `json:"textDocument"`
+}
+
+type foldingRange struct {
+	StartLine int              `json:"startLine"`
+	EndLine   int              `json:"endLine"`
+	Kind      foldingRangeKind `json:"kind,omitempty"`
+}
+
+func foldingRanges(req *jsonrpc2.Request) (interface{}, error) {
+	var params foldingRangeParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, err
+	}
+	logDebug(req, "LangServer: request received : Type: Folding Range URI: %s", params.TextDocument.URI)
+	content := getContent(params.TextDocument.URI)
+	lines := strings.Split(content, "\n")
+	var ranges []foldingRange
+	if util.IsConcept(util.ConvertURItoFilePath(params.TextDocument.URI)) {
+		concepts, _ := new(parser.ConceptParser).Parse(content, "")
+		ranges = conceptFoldingRanges(concepts, lines)
+	} else {
+		spec, _ := new(parser.SpecParser).ParseSpecText(content, "")
+		ranges = specFoldingRanges(spec, lines)
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].StartLine < ranges[j].StartLine })
+	return ranges, nil
+}
+
+func specFoldingRanges(spec *gauge.Specification, lines []string) []foldingRange {
+	teardown := len(lines)
+	for _, item := range spec.Items {
+		if item.Kind() == gauge.TearDownKind {
+			teardown = item.(*gauge.TearDown).LineNo - 1
+		}
+	}
+	ranges := commentFoldingRanges(spec.Items)
+	for _, item := range spec.Items {
+		switch i := item.(type) {
+		case *gauge.DataTable:
+			ranges = appendTableFoldingRange(ranges, lines, i.Table.LineNo-1)
+		case *gauge.Step:
+			ranges = appendInlineTableFoldingRange(ranges, lines, i)
+		case *gauge.Scenario:
+			end := i.Span.End - 1
+			if end >= teardown {
+				end = teardown - 1
+			}
+			ranges = appendRegionFoldingRange(ranges, lines, i.Heading.LineNo-1, end)
+			ranges = append(ranges, commentFoldingRanges(i.Items)...)
+			for _, step := range i.Steps {
+				ranges = appendInlineTableFoldingRange(ranges, lines, step)
+			}
+		}
+	}
+	return appendRegionFoldingRange(ranges, lines, teardown, len(lines)-1)
+}
+
+// conceptFoldingRanges folds each concept up to the heading of the next concept in the file.
+func conceptFoldingRanges(concepts []*gauge.Step, lines []string) []foldingRange {
+	sort.Slice(concepts, func(i, j int) bool { return concepts[i].LineNo < concepts[j].LineNo })
+	var ranges []foldingRange
+	for i, concept := range concepts {
+		end := len(lines) - 1
+		if i+1 < len(concepts) {
+			end = concepts[i+1].LineNo - 2
+		}
+		ranges = appendRegionFoldingRange(ranges, lines, concept.LineNo-1, end)
+		for _, step := range concept.ConceptSteps {
+			ranges = appendInlineTableFoldingRange(ranges, lines, step)
+		}
+	}
+	return ranges
+}
+
+// commentFoldingRanges folds the comments which span consecutive lines.
+func commentFoldingRanges(items []gauge.Item) []foldingRange {
+	var ranges []foldingRange
+	start, end := -1, -1
+	for _, item := range items {
+		comment, ok := item.(*gauge.Comment)
+		if !ok || strings.TrimSpace(comment.Value) == "" {
+			continue
+		}
+		if start >= 0 && comment.LineNo-1 == end+1 {
+			end++
+			continue
+		}
+		if end > start {
+			ranges = append(ranges, foldingRange{StartLine: start, EndLine: end, Kind: commentFold})
+		}
+		start, end = comment.LineNo-1, comment.LineNo-1
+	}
+	if end > start {
+		ranges = append(ranges, foldingRange{StartLine: start, EndLine: end, Kind: commentFold})
+	}
+	return ranges
+}
+
+func appendInlineTableFoldingRange(ranges []foldingRange, lines []string, step *gauge.Step) []foldingRange {
+	if !step.HasInlineTable {
+		return ranges
+	}
+	return appendTableFoldingRange(ranges, lines, step.LineNo)
+}
+
+func appendTableFoldingRange(ranges []foldingRange, lines []string, line int) []foldingRange {
+	start, end := tableAround(lines, line)
+	if start != line || end-1 <= start {
+		return ranges
+	}
+	return append(ranges, foldingRange{StartLine: start, EndLine: end - 1, Kind: regionFold})
+}
+
+// appendRegionFoldingRange folds the lines from start up to the last non blank line before end.
+func appendRegionFoldingRange(ranges []foldingRange, lines []string, start, end int) []foldingRange {
+	if end >= len(lines) {
+		end = len(lines) - 1
+	}
+	for end > start && strings.TrimSpace(lines[end]) == "" {
+		end--
+	}
+	if start < 0 || end <= start {
+		return ranges
+	}
+	return append(ranges, foldingRange{StartLine: start, EndLine: end, Kind: regionFold})
+}
@@ -0,0 +1,109 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func getFoldingRanges(t *testing.T, uri lsp.DocumentURI) interface{} {
+	b, _ := json.Marshal(foldingRangeParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}})
+	p := json.RawMessage(b)
+	got, err := foldingRanges(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	return got
+}
+
+func TestFoldingRangesForSpec(t *testing.T) {
+	specText := `# Spec
+
+|a|b|
+|-|-|
+|1|2|
+
+some comment
+more comment
+
+## Scenario one
+
+comment line
+* step
+   |x|y|
+   |-|-|
+   |1|2|
+
+* step two
+## Scenario two
+* another step
+___
+* teardown
+* another teardown
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, specText)
+
+	got := getFoldingRanges(t, uri)
+
+	want := []foldingRange{
+		{StartLine: 2, EndLine: 4, Kind: regionFold},
+		{StartLine: 6, EndLine: 7, Kind: commentFold},
+		{StartLine: 9, EndLine: 17, Kind: regionFold},
+		{StartLine: 13, EndLine: 15, Kind: regionFold},
+		{StartLine: 18, EndLine: 19, Kind: regionFold},
+		{StartLine: 20, EndLine: 22, Kind: regionFold},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestFoldingRangesForConcepts(t *testing.T) {
+	conceptText := `# first concept
+* step one
+* step two
+
+# second concept
+* step three
+   |x|y|
+   |-|-|
+   |1|2|
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.cpt")
+	openFilesCache.add(uri, conceptText)
+
+	got := getFoldingRanges(t, uri)
+
+	want := []foldingRange{
+		{StartLine: 0, EndLine: 2, Kind: regionFold},
+		{StartLine: 4, EndLine: 8, Kind: regionFold},
+		{StartLine: 6, EndLine: 8, Kind: regionFold},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
@@ -45,10 +45,137 @@ func format(request *jsonrpc2.Request) (interface{}, error) {
 		if !parseResult.Ok {
 			return nil, fmt.Errorf("failed to format document. Fix all the problems first")
 		}
-		newString := formatter.FormatSpecification(spec)
+		style, err := formatter.LoadStyle()
+		if err != nil {
+			return nil, fmt.Errorf("failed to format document. %s", err.Error())
+		}
+		newString := formatter.FormatSpecificationWithStyle(spec, style)
 		oldString := getContent(params.TextDocument.URI)
 		textEdit := createTextEdit(newString, 0, 0, len(strings.Split(oldString, "\n")), len(oldString))
 		return []lsp.TextEdit{textEdit}, nil
 	}
 	return nil, fmt.Errorf("failed to format document. %s is not a valid spec file", file)
 }
+
+func rangeFormat(request *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.DocumentRangeFormattingParams
+	if err := json.Unmarshal(*request.Params, &params); err != nil {
+		return nil, err
+	}
+	logDebug(request, "LangServer: request received : Type: Format Range URI: %s", params.TextDocument.URI)
+	file := util.ConvertURItoFilePath(params.TextDocument.URI)
+	if !util.IsValidSpecExtension(file) {
+		return nil, fmt.Errorf("failed to format selection. %s is not a valid spec file", file)
+	}
+	style, err := formatter.LoadStyle()
+	if err != nil {
+		return nil, fmt.Errorf("failed to format selection. %s", err.Error())
+	}
+	content := getContent(params.TextDocument.URI)
+	lines := strings.Split(content, "\n")
+	start, end := tableAround(lines, params.Range.Start.Line)
+	if start != -1 && params.Range.End.Line < end {
+		return alignTable(lines, start, end, style), nil
+	}
+	spec, parseResult, err := new(parser.SpecParser).Parse(content, gauge.NewConceptDictionary(), file)
+	if err != nil {
+		return nil, err
+	}
+	if !parseResult.Ok {
+		return nil, fmt.Errorf("failed to format selection. Fix all the problems first")
+	}
+	var edits []lsp.TextEdit
+	for _, scenario := range spec.Scenarios {
+		start, end := scenarioLines(spec, scenario, lines)
+		if end <= params.Range.Start.Line || start > params.Range.End.Line {
+			continue
+		}
+		newText := formatter.FormatScenarioWithStyle(scenario, style)
+		if end == len(lines) {
+			edits = append(edits, createTextEdit(strings.TrimSuffix(newText, "\n"), start, 0, end-1, len(lines[end-1])))
+		} else {
+			edits = append(edits, createTextEdit(newText, start, 0, end, 0))
+		}
+	}
+	return edits, nil
+}
+
+// scenarioLines gives the zero based range of lines, end exclusive, of a scenario. A scenario runs till the heading of
+// the next scenario, the teardown of the spec or the end of the file.
+func scenarioLines(spec *gauge.Specification, scenario *gauge.Scenario, lines []string) (int, int) {
+	end := len(lines)
+	if lines[end-1] == "" {
+		end--
+	}
+	for _, item := range spec.Items {
+		switch item.Kind() {
+		case gauge.ScenarioKind:
+			if s := item.(*gauge.Scenario); s.Heading.LineNo > scenario.Heading.LineNo && s.Heading.LineNo-1 < end {
+				end = s.Heading.LineNo - 1
+			}
+		case gauge.TearDownKind:
+			if t := item.(*gauge.TearDown); t.LineNo > scenario.Heading.LineNo && t.LineNo-1 < end {
+				end = t.LineNo - 1
+			}
+		}
+	}
+	return scenario.Heading.LineNo - 1, end
+}
+
+func onTypeFormat(request *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.DocumentOnTypeFormattingParams
+	if err := json.Unmarshal(*request.Params, &params); err != nil {
+		return nil, err
+	}
+	logDebug(request, "LangServer: request received : Type: Format On Type URI: %s", params.TextDocument.URI)
+	if !util.IsValidSpecExtension(util.ConvertURItoFilePath(params.TextDocument.URI)) {
+		return nil, nil
+	}
+	line := params.Position.Line
+	if params.Ch == "\n" {
+		line--
+	}
+	lines := strings.Split(getContent(params.TextDocument.URI), "\n")
+	start, end := tableAround(lines, line)
+	if start == -1 {
+		return nil, nil
+	}
+	style, err := formatter.LoadStyle()
+	if err != nil {
+		return nil, fmt.Errorf("failed to format table. %s", err.Error())
+	}
+	return alignTable(lines, start, end, style), nil
+}
+
+// tableAround gives the zero based range of lines, end exclusive, of the table rows around the given line. It returns
+// -1 as the start if the line is not a table row.
+func tableAround(lines []string, line int) (int, int) {
+	if line < 0 || line >= len(lines) || !isTableRow(lines[line]) {
+		return -1, -1
+	}
+	start, end := line, line+1
+	for start > 0 && isTableRow(lines[start-1]) {
+		start--
+	}
+	for end < len(lines) && isTableRow(lines[end]) {
+		end++
+	}
+	return start, end
+}
+
+func isTableRow(line string) bool {
+	row := strings.TrimSpace(line)
+	return row != "" && row[0] == '|' && row[len(row)-1] == '|'
+}
+
+func alignTable(lines []string, start, end int, style *formatter.Style) []lsp.TextEdit {
+	table, ok := formatter.AlignTable(lines[start:end], style)
+	if !ok {
+		return nil
+	}
+	table = strings.TrimSuffix(table, "\n")
+	if table == strings.Join(lines[start:end], "\n") {
+		return nil
+	}
+	return []lsp.TextEdit{createTextEdit(table, start, 0, end-1, len(lines[end-1]))}
+}
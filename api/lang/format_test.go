@@ -89,3 +89,146 @@ func TestFormatParseError(t *testing.T) {
 	}
 
 }
+
+func TestRangeFormatTable(t *testing.T) {
+	specText := `# Specification Heading
+
+## Scenario Heading
+* Step with table
+   |id|name|
+   |--|--|
+   |1|john|
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", specText)
+
+	want := []lsp.TextEdit{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 4, Character: 0},
+				End:   lsp.Position{Line: 6, Character: 11},
+			},
+			NewText: "   |id|name|\n   |--|----|\n   |1 |john|",
+		},
+	}
+
+	b, _ := json.Marshal(lsp.DocumentRangeFormattingParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"},
+		Range:        lsp.Range{Start: lsp.Position{Line: 5, Character: 0}, End: lsp.Position{Line: 6, Character: 5}},
+	})
+	p := json.RawMessage(b)
+
+	got, err := rangeFormat(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Expected error == nil in rangeFormat, got %s", err.Error())
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rangeFormat failed, want: `%v`, got: `%v`", want, got)
+	}
+}
+
+func TestRangeFormatScenario(t *testing.T) {
+	specText := `# Specification Heading
+
+## First Scenario
+*   Step one
+
+##    Second Scenario
+*   Step two
+
+## Third Scenario
+*   Step three
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", specText)
+
+	want := []lsp.TextEdit{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 5, Character: 0},
+				End:   lsp.Position{Line: 8, Character: 0},
+			},
+			NewText: "## Second Scenario\n* Step two\n\n",
+		},
+	}
+
+	b, _ := json.Marshal(lsp.DocumentRangeFormattingParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"},
+		Range:        lsp.Range{Start: lsp.Position{Line: 6, Character: 0}, End: lsp.Position{Line: 6, Character: 5}},
+	})
+	p := json.RawMessage(b)
+
+	got, err := rangeFormat(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Expected error == nil in rangeFormat, got %s", err.Error())
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rangeFormat failed, want: `%v`, got: `%v`", want, got)
+	}
+}
+
+func TestOnTypeFormatAlignsTable(t *testing.T) {
+	specText := `# Specification Heading
+
+## Scenario Heading
+* Step with table
+   |id|name|
+   |--|----|
+   |100|john|
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", specText)
+
+	want := []lsp.TextEdit{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 4, Character: 0},
+				End:   lsp.Position{Line: 6, Character: 13},
+			},
+			NewText: "   |id |name|\n   |---|----|\n   |100|john|",
+		},
+	}
+
+	for _, params := range []lsp.DocumentOnTypeFormattingParams{
+		{TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"}, Position: lsp.Position{Line: 6, Character: 13}, Ch: "|"},
+		{TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"}, Position: lsp.Position{Line: 7, Character: 0}, Ch: "\n"},
+	} {
+		b, _ := json.Marshal(params)
+		p := json.RawMessage(b)
+
+		got, err := onTypeFormat(&jsonrpc2.Request{Params: &p})
+		if err != nil {
+			t.Fatalf("Expected error == nil in onTypeFormat, got %s", err.Error())
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("onTypeFormat failed for %q, want: `%v`, got: `%v`", params.Ch, want, got)
+		}
+	}
+}
+
+func TestOnTypeFormatSkipsIncompleteRow(t *testing.T) {
+	specText := `# Specification Heading
+
+## Scenario Heading
+* Step with table
+   |id|name|
+   |--|----|
+   |100|
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", specText)
+
+	b, _ := json.Marshal(lsp.DocumentOnTypeFormattingParams{TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"}, Position: lsp.Position{Line: 6, Character: 8}, Ch: "|"})
+	p := json.RawMessage(b)
+
+	got, err := onTypeFormat(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Expected error == nil in onTypeFormat, got %s", err.Error())
+	}
+	if got.([]lsp.TextEdit) != nil {
+		t.Errorf("Expected no edits for an incomplete row, got: `%v`", got)
+	}
+}
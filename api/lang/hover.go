@@ -0,0 +1,229 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const maxSampleValues = 3
+
+var paramPattern = regexp.MustCompile(`<([^<>]*)>`)
+
+func hover(req *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.TextDocumentPositionParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, err
+	}
+	logDebug(req, "LangServer: request received : Type: Hover URI: %s", params.TextDocument.URI)
+	line := getLine(params.TextDocument.URI, params.Position.Line)
+	if isTagsLine(line) {
+		return tagHover(line, params.Position), nil
+	}
+	fileContent := getContent(params.TextDocument.URI)
+	if util.IsConcept(util.ConvertURItoFilePath(params.TextDocument.URI)) {
+		concepts, _ := new(parser.ConceptParser).Parse(fileContent, "")
+		for _, concept := range concepts {
+			for _, step := range concept.ConceptSteps {
+				if (step.LineNo - 1) == params.Position.Line {
+					return stepHover(params.TextDocument.URI, step, params.Position.Line)
+				}
+			}
+		}
+		return nil, nil
+	}
+	spec, _ := new(parser.SpecParser).ParseSpecText(fileContent, "")
+	for _, item := range spec.AllItems() {
+		if item.Kind() != gauge.StepKind {
+			continue
+		}
+		step := item.(*gauge.Step)
+		if (step.LineNo - 1) != params.Position.Line {
+			continue
+		}
+		if h := dynamicParamHover(spec, step, line, params.Position); h != nil {
+			return h, nil
+		}
+		return stepHover(params.TextDocument.URI, step, params.Position.Line)
+	}
+	return nil, nil
+}
+
+func isTagsLine(line string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(line)), "tags:")
+}
+
+// tagHover tells how many scenarios carry the tag under the cursor, either on the scenario or on its spec.
+func tagHover(line string, position lsp.Position) *lsp.Hover {
+	start := strings.Index(line, ":") + 1
+	for _, tag := range strings.Split(line[start:], ",") {
+		end := start + len(tag)
+		if position.Character >= start && position.Character <= end && strings.TrimSpace(tag) != "" {
+			tag = strings.TrimSpace(tag)
+			count := 0
+			for _, detail := range provider.GetAvailableSpecDetails(provider.GetSpecDirs()) {
+				if !detail.HasSpec() {
+					continue
+				}
+				for _, scenario := range detail.Spec.Scenarios {
+					if hasTag(detail.Spec.Tags, tag) || hasTag(scenario.Tags, tag) {
+						count++
+					}
+				}
+			}
+			return newHover(position.Line, start, end, lsp.RawMarkedString(fmt.Sprintf("`%s` is carried by %d scenario(s)", tag, count)))
+		}
+		start = end + 1
+	}
+	return nil
+}
+
+func hasTag(tags *gauge.Tags, tag string) bool {
+	if tags == nil {
+		return false
+	}
+	for _, t := range tags.Values() {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// dynamicParamHover shows the data table column of the dynamic parameter under the cursor with a few of its values.
+func dynamicParamHover(spec *gauge.Specification, step *gauge.Step, line string, position lsp.Position) *lsp.Hover {
+	for _, index := range paramPattern.FindAllStringSubmatchIndex(line, -1) {
+		if position.Character < index[0] || position.Character >= index[1] {
+			continue
+		}
+		name := line[index[2]:index[3]]
+		table := dataTableOf(spec, step)
+		if table == nil {
+			return nil
+		}
+		cells, err := table.Get(name)
+		if err != nil {
+			return nil
+		}
+		var values []string
+		for i := 0; i < len(cells) && i < maxSampleValues; i++ {
+			values = append(values, fmt.Sprintf("`%s`", cells[i].GetValue()))
+		}
+		text := fmt.Sprintf("Data table column `%s` with %d row(s)", name, len(cells))
+		if len(values) > 0 {
+			text = fmt.Sprintf("%s\n\nSample values: %s", text, strings.Join(values, ", "))
+		}
+		return newHover(position.Line, index[0], index[1], lsp.RawMarkedString(text))
+	}
+	return nil
+}
+
+// dataTableOf gives the data table of the scenario of the step, or else the data table of the spec.
+func dataTableOf(spec *gauge.Specification, step *gauge.Step) *gauge.Table {
+	for _, scenario := range spec.Scenarios {
+		if scenario.InSpan(step.LineNo) && scenario.DataTable.IsInitialized() {
+			return &scenario.DataTable.Table
+		}
+	}
+	if spec.DataTable.IsInitialized() {
+		return &spec.DataTable.Table
+	}
+	return nil
+}
+
+// stepHover shows the steps of a concept with its arguments, or the implementation of a step.
+func stepHover(uri lsp.DocumentURI, step *gauge.Step, line int) (interface{}, error) {
+	if concept := provider.SearchConceptDictionary(step.Value); concept != nil {
+		var steps []string
+		for _, s := range concept.ConceptStep.ConceptSteps {
+			steps = append(steps, strings.TrimRight(formatter.FormatStep(substituteArgs(s, concept.ConceptStep, step)), "\n"))
+		}
+		contents := []lsp.MarkedString{
+			lsp.RawMarkedString(fmt.Sprintf("Concept defined in `%s:%d`", util.RelPathToProjectRoot(concept.FileName), concept.ConceptStep.LineNo)),
+			{Language: "gauge", Value: strings.Join(steps, "\n")},
+		}
+		return &lsp.Hover{Contents: contents, Range: stepRange(uri, line)}, nil
+	}
+	if lRunner.runner == nil {
+		return nil, nil
+	}
+	res, err := getStepNameResponse(step.Value)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || !res.GetIsStepPresent() {
+		return nil, nil
+	}
+	text := fmt.Sprintf("Implemented in `%s:%d`", util.RelPathToProjectRoot(res.GetFileName()), res.GetSpan().GetStart())
+	if len(res.GetStepName()) > 0 {
+		var names []string
+		for _, match := range paramPattern.FindAllStringSubmatch(res.GetStepName()[0], -1) {
+			names = append(names, fmt.Sprintf("`%s`", match[1]))
+		}
+		if len(names) > 0 {
+			text = fmt.Sprintf("%s\n\nParameters: %s", text, strings.Join(names, ", "))
+		}
+	}
+	return &lsp.Hover{Contents: []lsp.MarkedString{lsp.RawMarkedString(text)}, Range: stepRange(uri, line)}, nil
+}
+
+// substituteArgs gives a copy of the concept step with the parameters of the concept replaced by the arguments of the
+// step which uses the concept.
+func substituteArgs(conceptStep, concept, usage *gauge.Step) *gauge.Step {
+	args := make(map[string]*gauge.StepArg)
+	for i, param := range concept.Args {
+		if i < len(usage.Args) {
+			args[param.Value] = usage.Args[i]
+		}
+	}
+	s := &gauge.Step{Value: conceptStep.Value}
+	for _, arg := range conceptStep.Args {
+		if a, ok := args[arg.Name]; ok && arg.ArgType == gauge.Dynamic {
+			arg = a
+		}
+		s.Args = append(s.Args, arg)
+	}
+	return s
+}
+
+func stepRange(uri lsp.DocumentURI, line int) *lsp.Range {
+	return &lsp.Range{
+		Start: lsp.Position{Line: line, Character: 0},
+		End:   lsp.Position{Line: line, Character: len(getLine(uri, line))},
+	}
+}
+
+func newHover(lineNo, start, end int, contents ...lsp.MarkedString) *lsp.Hover {
+	return &lsp.Hover{
+		Contents: contents,
+		Range: &lsp.Range{
+			Start: lsp.Position{Line: lineNo, Character: start},
+			End:   lsp.Position{Line: lineNo, Character: end},
+		},
+	}
+}
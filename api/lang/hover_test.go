@@ -0,0 +1,161 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/api/infoGatherer"
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type hoverInfoProvider struct {
+	dummyInfoProvider
+	concept *gauge.Concept
+}
+
+func (p hoverInfoProvider) SearchConceptDictionary(stepValue string) *gauge.Concept {
+	return p.concept
+}
+
+func getHover(t *testing.T, uri lsp.DocumentURI, position lsp.Position) interface{} {
+	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}, Position: position})
+	p := json.RawMessage(b)
+	got, err := hover(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Expected error == nil in hover, got %s", err.Error())
+	}
+	return got
+}
+
+func TestHoverOnConceptStep(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* login as \"admin\"\n")
+	provider = hoverInfoProvider{concept: &gauge.Concept{FileName: "login.cpt", ConceptStep: &gauge.Step{
+		Value:  "login as {}",
+		LineNo: 1,
+		Args:   []*gauge.StepArg{{Name: "user", Value: "user", ArgType: gauge.Dynamic}},
+		ConceptSteps: []*gauge.Step{
+			{Value: "enter {}", Args: []*gauge.StepArg{{Name: "user", Value: "user", ArgType: gauge.Dynamic}}},
+			{Value: "click {}", Args: []*gauge.StepArg{{Value: "login", ArgType: gauge.Static}}},
+		},
+	}}}
+
+	want := &lsp.Hover{
+		Contents: []lsp.MarkedString{
+			lsp.RawMarkedString("Concept defined in `login.cpt:1`"),
+			{Language: "gauge", Value: "* enter \"admin\"\n* click \"login\""},
+		},
+		Range: &lsp.Range{Start: lsp.Position{Line: 2, Character: 0}, End: lsp.Position{Line: 2, Character: 18}},
+	}
+	got := getHover(t, uri, lsp.Position{Line: 2, Character: 4})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestHoverOnImplementedStep(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* Say \"hi\" to \"gauge\"\n")
+	provider = hoverInfoProvider{}
+	responses := map[gm.Message_MessageType]interface{}{}
+	responses[gm.Message_StepNameResponse] = &gm.StepNameResponse{
+		IsStepPresent: true,
+		StepName:      []string{"Say <greeting> to <name>"},
+		FileName:      "step_impl.js",
+		Span:          &gm.Span{Start: 12},
+	}
+	lRunner.runner = &runner.GrpcRunner{Client: &mockLspClient{responses: responses}, Timeout: time.Second * 30}
+	defer func() { lRunner.runner = nil }()
+
+	want := &lsp.Hover{
+		Contents: []lsp.MarkedString{lsp.RawMarkedString("Implemented in `step_impl.js:12`\n\nParameters: `greeting`, `name`")},
+		Range:    &lsp.Range{Start: lsp.Position{Line: 2, Character: 0}, End: lsp.Position{Line: 2, Character: 21}},
+	}
+	got := getHover(t, uri, lsp.Position{Line: 2, Character: 4})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestHoverOnDynamicParam(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, `# Spec
+
+   |name|
+   |----|
+   |john|
+   |mary|
+   |jane|
+   |mark|
+
+## Scenario
+* Say hello to <name>
+`)
+	provider = hoverInfoProvider{}
+
+	want := &lsp.Hover{
+		Contents: []lsp.MarkedString{lsp.RawMarkedString("Data table column `name` with 4 row(s)\n\nSample values: `john`, `mary`, `jane`")},
+		Range:    &lsp.Range{Start: lsp.Position{Line: 10, Character: 15}, End: lsp.Position{Line: 10, Character: 21}},
+	}
+	got := getHover(t, uri, lsp.Position{Line: 10, Character: 17})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestHoverOnTag(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n\ntags: login, smoke\n\n## Scenario\n* step\n")
+	specs := []*infoGatherer.SpecDetail{
+		{Spec: &gauge.Specification{
+			Heading:   &gauge.Heading{Value: "Spec"},
+			Tags:      &gauge.Tags{RawValues: [][]string{{"login"}}},
+			Scenarios: []*gauge.Scenario{{}, {}},
+		}},
+		{Spec: &gauge.Specification{
+			Heading: &gauge.Heading{Value: "Another Spec"},
+			Scenarios: []*gauge.Scenario{
+				{Tags: &gauge.Tags{RawValues: [][]string{{"smoke"}, {"login"}}}},
+				{Tags: &gauge.Tags{RawValues: [][]string{{"smoke"}}}},
+			},
+		}},
+	}
+	provider = hoverInfoProvider{dummyInfoProvider: dummyInfoProvider{specsFunc: func([]string) []*infoGatherer.SpecDetail { return specs }}}
+
+	want := &lsp.Hover{
+		Contents: []lsp.MarkedString{lsp.RawMarkedString("`login` is carried by 3 scenario(s)")},
+		Range:    &lsp.Range{Start: lsp.Position{Line: 2, Character: 5}, End: lsp.Position{Line: 2, Character: 11}},
+	}
+	got := getHover(t, uri, lsp.Position{Line: 2, Character: 8})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
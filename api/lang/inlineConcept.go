@@ -0,0 +1,95 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/refactor"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	inlineConceptCommand        = "gauge.inline.concept"
+	inlineConceptTitle          = "Inline concept"
+	inlineAllConceptTitle       = "Inline all usages of concept"
+	inlineAndRemoveConceptTitle = "Inline all usages and remove concept"
+)
+
+type inlineConceptInfo struct {
+	URI              lsp.DocumentURI `json:"uri"`
+	Line             int             `json:"line"`
+	AllUsages        bool            `json:"allUsages"`
+	RemoveDefinition bool            `json:"removeDefinition"`
+}
+
+func getInlineConceptActions(uri lsp.DocumentURI, line int) []lsp.Command {
+	step := getStepAt(uri, line)
+	if step == nil || provider.SearchConceptDictionary(step.Value) == nil {
+		return nil
+	}
+	return []lsp.Command{
+		createCodeAction(inlineConceptCommand, inlineConceptTitle, []interface{}{inlineConceptInfo{URI: uri, Line: line}}),
+		createCodeAction(inlineConceptCommand, inlineAllConceptTitle, []interface{}{inlineConceptInfo{URI: uri, Line: line, AllUsages: true}}),
+		createCodeAction(inlineConceptCommand, inlineAndRemoveConceptTitle, []interface{}{inlineConceptInfo{URI: uri, Line: line, AllUsages: true, RemoveDefinition: true}}),
+	}
+}
+
+func inlineConcept(req *jsonrpc2.Request) (interface{}, error) {
+	var params inlineConceptInfo
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, fmt.Errorf("Failed to parse request %s", err.Error())
+	}
+	step := getStepAt(params.URI, params.Line)
+	if step == nil {
+		return nil, fmt.Errorf("inline concept is supported for concept usages only")
+	}
+	file := ""
+	if !params.AllUsages {
+		file = util.ConvertURItoFilePath(params.URI)
+	}
+	result := refactor.GetInlineConceptChanges(step.GetLineText(), file, step.LineNo, params.RemoveDefinition, provider.GetSpecDirs())
+	return getWorkspaceEdit(req, result.Success, result.Errors, result.Warnings, append(result.SpecsChanged, result.ConceptsChanged...))
+}
+
+func getStepAt(uri lsp.DocumentURI, line int) *gauge.Step {
+	file := util.ConvertURItoFilePath(uri)
+	if util.IsConcept(file) {
+		concepts, _ := new(parser.ConceptParser).Parse(getContent(uri), file)
+		for _, concept := range concepts {
+			for _, step := range concept.ConceptSteps {
+				if step.LineNo-1 == line {
+					return step
+				}
+			}
+		}
+	}
+	if util.IsSpec(file) {
+		spec, _ := new(parser.SpecParser).ParseSpecText(getContent(uri), file)
+		for _, step := range spec.Steps() {
+			if step.LineNo-1 == line {
+				return step
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type workDoneProgressCreateParams struct {
+	Token string `json:"token"`
+}
+
+type progressParams struct {
+	Token string      `json:"token"`
+	Value interface{} `json:"value"`
+}
+
+type workDoneProgressBegin struct {
+	Kind        string `json:"kind"`
+	Title       string `json:"title"`
+	Cancellable bool   `json:"cancellable"`
+	Message     string `json:"message,omitempty"`
+}
+
+type workDoneProgressReport struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message,omitempty"`
+}
+
+type workDoneProgressEnd struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message,omitempty"`
+}
+
+var progressTokens uint64
+
+// workDoneProgress reports the progress of a long operation to the client. Nothing is reported if the client does
+// not support work done progress.
+type workDoneProgress struct {
+	token string
+	ctx   context.Context
+	conn  jsonrpc2.JSONRPC2
+}
+
+func beginProgress(ctx context.Context, conn jsonrpc2.JSONRPC2, title, message string) *workDoneProgress {
+	if !clientCapabilities.Window.WorkDoneProgress {
+		return &workDoneProgress{}
+	}
+	token := fmt.Sprintf("gauge-progress-%d", atomic.AddUint64(&progressTokens, 1))
+	var result interface{}
+	if err := conn.Call(ctx, "window/workDoneProgress/create", workDoneProgressCreateParams{Token: token}, &result); err != nil {
+		logDebug(nil, "Unable to create work done progress. %s", err.Error())
+		return &workDoneProgress{}
+	}
+	p := &workDoneProgress{token: token, ctx: ctx, conn: conn}
+	p.notify(workDoneProgressBegin{Kind: "begin", Title: title, Message: message})
+	return p
+}
+
+func (p *workDoneProgress) report(message string) {
+	p.notify(workDoneProgressReport{Kind: "report", Message: message})
+}
+
+func (p *workDoneProgress) end(message string) {
+	p.notify(workDoneProgressEnd{Kind: "end", Message: message})
+}
+
+func (p *workDoneProgress) notify(value interface{}) {
+	if p.conn == nil {
+		return
+	}
+	p.conn.Notify(p.ctx, "$/progress", progressParams{Token: p.token, Value: value})
+}
@@ -0,0 +1,77 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type progressConn struct {
+	MockConn
+	calls         []string
+	notifications []interface{}
+}
+
+func (conn *progressConn) Call(ctx context.Context, method string, params, result interface{}, opt ...jsonrpc2.CallOption) error {
+	conn.calls = append(conn.calls, method)
+	return nil
+}
+
+func (conn *progressConn) Notify(ctx context.Context, method string, params interface{}, opt ...jsonrpc2.CallOption) error {
+	conn.notifications = append(conn.notifications, params)
+	return nil
+}
+
+func TestProgressIsReportedToClientWhichSupportsIt(t *testing.T) {
+	clientCapabilities = ClientCapabilities{Window: windowClientCapabilities{WorkDoneProgress: true}}
+	defer func() { clientCapabilities = ClientCapabilities{} }()
+	conn := &progressConn{}
+
+	p := beginProgress(context.Background(), conn, "Loading", "Loading specifications")
+	p.report("Starting runner")
+	p.end("Loaded")
+
+	if !reflect.DeepEqual(conn.calls, []string{"window/workDoneProgress/create"}) {
+		t.Errorf("want progress to be created, got calls: `%v`", conn.calls)
+	}
+	want := []interface{}{
+		progressParams{Token: p.token, Value: workDoneProgressBegin{Kind: "begin", Title: "Loading", Message: "Loading specifications"}},
+		progressParams{Token: p.token, Value: workDoneProgressReport{Kind: "report", Message: "Starting runner"}},
+		progressParams{Token: p.token, Value: workDoneProgressEnd{Kind: "end", Message: "Loaded"}},
+	}
+	if !reflect.DeepEqual(conn.notifications, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, conn.notifications)
+	}
+}
+
+func TestProgressIsNotReportedToClientWhichDoesNotSupportIt(t *testing.T) {
+	clientCapabilities = ClientCapabilities{}
+	conn := &progressConn{}
+
+	p := beginProgress(context.Background(), conn, "Loading", "Loading specifications")
+	p.report("Starting runner")
+	p.end("Loaded")
+
+	if len(conn.calls) != 0 || len(conn.notifications) != 0 {
+		t.Errorf("want no progress to be reported, got calls: `%v` and notifications: `%v`", conn.calls, conn.notifications)
+	}
+}
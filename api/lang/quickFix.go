@@ -0,0 +1,267 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/validation"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const quickFixCommand = "gauge.apply.quickFix"
+
+type executeCommandParams struct {
+	Command   string            `json:"command"`
+	Arguments []json.RawMessage `json:"arguments,omitempty"`
+}
+
+type applyWorkspaceEditParams struct {
+	Label string            `json:"label,omitempty"`
+	Edit  lsp.WorkspaceEdit `json:"edit"`
+}
+
+type applyWorkspaceEditResponse struct {
+	Applied bool `json:"applied"`
+}
+
+type quickFix func(uri lsp.DocumentURI, lines []string, line int) []lsp.Command
+
+// quickFixes are the fixes offered for the diagnostics of parse errors and warnings, by their error code.
+var quickFixes = map[parser.ErrorCode]quickFix{
+	parser.SpecHeadingNotFound:       addSpecHeading,
+	parser.DuplicateScenario:         removeDuplicateScenario,
+	parser.UnresolvedDynamicParam:    replaceUnresolvedParam,
+	parser.OrphanTable:               convertToDataTable,
+	parser.TableRowCellCountMismatch: fixTableRowCells,
+}
+
+func getQuickFixActions(uri lsp.DocumentURI, d lsp.Diagnostic) ([]lsp.Command, bool) {
+	fix, ok := quickFixes[parser.ErrorCode(d.Code)]
+	if !ok {
+		return nil, false
+	}
+	return fix(uri, strings.Split(getContent(uri), "\n"), d.Range.Start.Line), true
+}
+
+func addSpecHeading(uri lsp.DocumentURI, lines []string, line int) []lsp.Command {
+	file := util.ConvertURItoFilePath(uri)
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	name = strings.TrimSpace(strings.NewReplacer("_", " ", "-", " ").Replace(name))
+	if name == "" {
+		name = "Specification"
+	}
+	first, size := utf8.DecodeRuneInString(name)
+	heading := fmt.Sprintf("# %c%s\n\n", unicode.ToUpper(first), name[size:])
+	return []lsp.Command{createQuickFix(uri, "Add spec heading", createTextEdit(heading, 0, 0, 0, 0))}
+}
+
+// removeDuplicateScenario removes the scenario at the line up to the next scenario or the teardown.
+func removeDuplicateScenario(uri lsp.DocumentURI, lines []string, line int) []lsp.Command {
+	tokens, _ := new(parser.SpecParser).GenerateTokens(strings.Join(lines, "\n"), "")
+	end := len(lines)
+	for _, token := range tokens {
+		if token.LineNo-1 > line && (token.Kind == gauge.ScenarioKind || token.Kind == gauge.TearDownKind) {
+			end = token.LineNo - 1
+			break
+		}
+	}
+	return []lsp.Command{createQuickFix(uri, "Remove duplicate scenario", removeLines(lines, line, end))}
+}
+
+// replaceUnresolvedParam replaces each unresolved param in the line with the closest param which can be used there,
+// the data table columns in a spec or the concept params in a concept file.
+func replaceUnresolvedParam(uri lsp.DocumentURI, lines []string, line int) []lsp.Command {
+	if line >= len(lines) {
+		return nil
+	}
+	names := dynamicParamsAt(uri, line)
+	var actions []lsp.Command
+	for _, index := range paramPattern.FindAllStringSubmatchIndex(lines[line], -1) {
+		param := lines[line][index[2]:index[3]]
+		if strings.Contains(param, ":") || util.ListContains(names, param) {
+			continue
+		}
+		closest := closestName(param, names)
+		if closest == "" {
+			continue
+		}
+		title := fmt.Sprintf("Replace <%s> with <%s>", param, closest)
+		actions = append(actions, createQuickFix(uri, title, createTextEdit("<"+closest+">", line, index[0], line, index[1])))
+	}
+	return actions
+}
+
+func closestName(name string, names []string) string {
+	closest, distance := "", -1
+	for _, n := range names {
+		if d := util.EditDistance(strings.ToLower(name), strings.ToLower(n)); distance < 0 || d < distance {
+			closest, distance = n, d
+		}
+	}
+	return closest
+}
+
+// convertToDataTable moves a table which does not belong to any step before the first scenario,
+// if the spec does not have a data table yet.
+func convertToDataTable(uri lsp.DocumentURI, lines []string, line int) []lsp.Command {
+	spec, _ := new(parser.SpecParser).ParseSpecText(getContent(uri), util.ConvertURItoFilePath(uri))
+	if spec.DataTable.IsInitialized() || len(spec.Scenarios) == 0 {
+		return nil
+	}
+	start, end := tableAround(lines, line)
+	heading := spec.Scenarios[0].Heading.LineNo - 1
+	if start < 0 || start <= heading {
+		return nil
+	}
+	var table []string
+	for _, row := range lines[start:end] {
+		table = append(table, strings.TrimSpace(row))
+	}
+	return []lsp.Command{createQuickFix(uri, "Convert to data table",
+		createTextEdit(strings.Join(table, "\n")+"\n\n", heading, 0, heading, 0),
+		removeLines(lines, start, end),
+	)}
+}
+
+// fixTableRowCells adds empty cells to a row which has fewer cells than the header, or removes the extra cells.
+func fixTableRowCells(uri lsp.DocumentURI, lines []string, line int) []lsp.Command {
+	start, _ := tableAround(lines, line)
+	if start < 0 {
+		return nil
+	}
+	headers, ok := formatter.TableCells(lines[start])
+	if !ok {
+		return nil
+	}
+	cells, ok := formatter.TableCells(lines[line])
+	if !ok || len(cells) == len(headers) {
+		return nil
+	}
+	title := "Remove extra table cells"
+	if len(cells) < len(headers) {
+		title = "Add missing table cells"
+		cells = append(cells, make([]string, len(headers)-len(cells))...)
+	}
+	indent := lines[line][:len(lines[line])-len(strings.TrimLeft(lines[line], " \t"))]
+	row := fmt.Sprintf("%s|%s|", indent, strings.Join(cells[:len(headers)], "|"))
+	return []lsp.Command{createQuickFix(uri, title, createTextEdit(row, line, 0, line, len(lines[line])))}
+}
+
+// changeToClosestStep offers to change the unimplemented step at the line to the implemented step or concept which is
+// most similar to it, keeping the arguments of the step.
+func changeToClosestStep(uri lsp.DocumentURI, line int) []lsp.Command {
+	step := stepAt(uri, line)
+	if step == nil {
+		return nil
+	}
+	match := validation.ClosestMatch(step.Value, implementedStepTexts())
+	if match == "" {
+		return nil
+	}
+	text, ok := rephraseStep(step, match)
+	if !ok {
+		return nil
+	}
+	lineText := getLine(uri, line)
+	start := strings.Index(lineText, "*")
+	return []lsp.Command{createQuickFix(uri, fmt.Sprintf("Change to '%s'", match), createTextEdit(text, line, start, line, len(lineText)))}
+}
+
+// implementedStepTexts gives the steps implemented in the runner and the concept headings.
+func implementedStepTexts() []string {
+	var r runner.Runner
+	if lRunner.runner != nil {
+		r = lRunner.runner
+	}
+	var concepts []*gm.ConceptInfo
+	if provider != nil {
+		concepts = provider.Concepts()
+	}
+	return validation.ImplementedStepTexts(r, concepts)
+}
+
+// rephraseStep gives the step with its text changed to the given text, keeping the arguments of the step. An inline
+// table stays below the step, so the text has to take the table as its last parameter.
+func rephraseStep(step *gauge.Step, text string) (string, bool) {
+	value, err := parser.ExtractStepValueAndParams(text, false)
+	if err != nil {
+		return "", false
+	}
+	rephrased := &gauge.Step{Value: value.StepValue, Args: step.Args, Suffix: step.Suffix}
+	if step.HasInlineTable {
+		if !strings.HasSuffix(value.StepValue, gauge.ParameterPlaceholder) {
+			return "", false
+		}
+		rephrased.Value = strings.TrimSpace(strings.TrimSuffix(value.StepValue, gauge.ParameterPlaceholder))
+		rephrased.Args = step.Args[:len(step.Args)-1]
+	}
+	return strings.TrimSuffix(formatter.FormatStep(rephrased), "\n"), true
+}
+
+// removeLines gives the edit which removes the lines from start up to, but not including, end.
+func removeLines(lines []string, start, end int) lsp.TextEdit {
+	if end < len(lines) {
+		return createTextEdit("", start, 0, end, 0)
+	}
+	return createTextEdit("", start, 0, len(lines)-1, len(lines[len(lines)-1]))
+}
+
+func createQuickFix(uri lsp.DocumentURI, title string, edits ...lsp.TextEdit) lsp.Command {
+	edit := lsp.WorkspaceEdit{Changes: map[string][]lsp.TextEdit{string(uri): edits}}
+	return createCodeAction(quickFixCommand, title, []interface{}{edit})
+}
+
+// applyQuickFix applies the edit of a quick fix chosen by the user, by asking the client to apply it.
+func applyQuickFix(ctx context.Context, conn jsonrpc2.JSONRPC2, req *jsonrpc2.Request) (interface{}, error) {
+	var params executeCommandParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, fmt.Errorf("failed to parse request %v", err)
+	}
+	if params.Command != quickFixCommand {
+		return nil, fmt.Errorf("unknown command %s", params.Command)
+	}
+	if len(params.Arguments) != 1 {
+		return nil, fmt.Errorf("%s expects a workspace edit as its only argument", quickFixCommand)
+	}
+	var edit lsp.WorkspaceEdit
+	if err := json.Unmarshal(params.Arguments[0], &edit); err != nil {
+		return nil, fmt.Errorf("failed to parse workspace edit %v", err)
+	}
+	var result applyWorkspaceEditResponse
+	if err := conn.Call(ctx, "workspace/applyEdit", applyWorkspaceEditParams{Edit: edit}, &result); err != nil {
+		return nil, err
+	}
+	if !result.Applied {
+		return nil, fmt.Errorf("client could not apply the quick fix")
+	}
+	return nil, nil
+}
@@ -0,0 +1,310 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func getQuickFixes(t *testing.T, uri lsp.DocumentURI, code parser.ErrorCode, line int) []lsp.Command {
+	d := lsp.Diagnostic{
+		Range: lsp.Range{Start: lsp.Position{Line: line, Character: 0}, End: lsp.Position{Line: line, Character: 10}},
+		Code:  string(code),
+	}
+	got, err := getSpecCodeAction(lsp.CodeActionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Context:      lsp.CodeActionContext{Diagnostics: []lsp.Diagnostic{d}},
+		Range:        d.Range,
+	})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	return got
+}
+
+func wantQuickFix(uri lsp.DocumentURI, title string, edits ...lsp.TextEdit) []lsp.Command {
+	return []lsp.Command{{
+		Command:   quickFixCommand,
+		Title:     title,
+		Arguments: []interface{}{lsp.WorkspaceEdit{Changes: map[string][]lsp.TextEdit{string(uri): edits}}},
+	}}
+}
+
+func TestQuickFixToAddSpecHeading(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("user_login.spec")
+	openFilesCache.add(uri, "## Scenario\n* step")
+
+	got := getQuickFixes(t, uri, parser.SpecHeadingNotFound, 0)
+
+	want := wantQuickFix(uri, "Add spec heading", createTextEdit("# User login\n\n", 0, 0, 0, 0))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToAddSpecHeadingWithNonASCIIFileName(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("éclair_order.spec")
+	openFilesCache.add(uri, "## Scenario\n* step")
+
+	got := getQuickFixes(t, uri, parser.SpecHeadingNotFound, 0)
+
+	want := wantQuickFix(uri, "Add spec heading", createTextEdit("# Éclair order\n\n", 0, 0, 0, 0))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToAddSpecHeadingIsOfferedOnceForAllItsDiagnostics(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("user_login.spec")
+	openFilesCache.add(uri, "## Scenario\n* step")
+	var diagnostics []lsp.Diagnostic
+	for _, line := range []int{0, 1} {
+		r := lsp.Range{Start: lsp.Position{Line: line, Character: 0}, End: lsp.Position{Line: line, Character: 10}}
+		diagnostics = append(diagnostics, lsp.Diagnostic{Range: r, Code: string(parser.SpecHeadingNotFound)})
+	}
+
+	got, err := getSpecCodeAction(lsp.CodeActionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Context:      lsp.CodeActionContext{Diagnostics: diagnostics},
+	})
+
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	want := wantQuickFix(uri, "Add spec heading", createTextEdit("# User login\n\n", 0, 0, 0, 0))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToRemoveDuplicateScenario(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* step\n## scenario\n* step two\n\n## Another scenario\n* step three")
+
+	got := getQuickFixes(t, uri, parser.DuplicateScenario, 3)
+
+	want := wantQuickFix(uri, "Remove duplicate scenario", createTextEdit("", 3, 0, 6, 0))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToReplaceUnresolvedParamWithClosestColumn(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n|name|email|\n|----|-----|\n|foo |bar  |\n## Scenario\n* login as <nmae> with <email>")
+
+	got := getQuickFixes(t, uri, parser.UnresolvedDynamicParam, 5)
+
+	want := wantQuickFix(uri, "Replace <nmae> with <name>", createTextEdit("<name>", 5, 11, 5, 17))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToReplaceUnresolvedParamInConcept(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.cpt")
+	openFilesCache.add(uri, "# login as <user>\n* enter <usr>")
+
+	got := getQuickFixes(t, uri, parser.UnresolvedDynamicParam, 1)
+
+	want := wantQuickFix(uri, "Replace <usr> with <user>", createTextEdit("<user>", 1, 8, 1, 13))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToConvertOrphanTableToDataTable(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n\n## Scenario\nsome comment\n  |a|b|\n  |1|2|\n* step")
+
+	got := getQuickFixes(t, uri, parser.OrphanTable, 4)
+
+	want := wantQuickFix(uri, "Convert to data table",
+		createTextEdit("|a|b|\n|1|2|\n\n", 2, 0, 2, 0),
+		createTextEdit("", 4, 0, 6, 0),
+	)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToConvertOrphanTableWhenSpecHasDataTable(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n|x|\n|-|\n|1|\n## Scenario\nsome comment\n|a|b|\n|1|2|\n* step")
+
+	got := getQuickFixes(t, uri, parser.OrphanTable, 6)
+
+	if len(got) != 0 {
+		t.Errorf("want no quick fixes, got: `%v`", got)
+	}
+}
+
+func TestQuickFixForTableRowWithMissingCells(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* step\n   |a|b|c|\n   |-|-|-|\n   |1|2|")
+
+	got := getQuickFixes(t, uri, parser.TableRowCellCountMismatch, 5)
+
+	want := wantQuickFix(uri, "Add missing table cells", createTextEdit("   |1|2||", 5, 0, 5, 8))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixForTableRowWithExtraCells(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n|a|b|\n|-|-|\n|1|2|3|\n## Scenario\n* step")
+
+	got := getQuickFixes(t, uri, parser.TableRowCellCountMismatch, 3)
+
+	want := wantQuickFix(uri, "Remove extra table cells", createTextEdit("|1|2|", 3, 0, 3, 7))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func implementedSteps(steps ...string) {
+	responses := map[gm.Message_MessageType]interface{}{}
+	responses[gm.Message_StepNamesResponse] = &gm.StepNamesResponse{Steps: steps}
+	lRunner.runner = &runner.GrpcRunner{Client: &mockLspClient{responses: responses}, Timeout: time.Second * 30}
+}
+
+func TestQuickFixToChangeUnimplementedStepToClosestStepKeepsArgs(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* Login as user \"john\" with \"secret\"\n")
+	provider = &dummyInfoProvider{}
+	implementedSteps("Log in as user <name> with <password>", "Log out")
+
+	got := getQuickFixes(t, uri, "a stub for unimplemented step", 2)
+
+	want := wantQuickFix(uri, "Change to 'Log in as user <name> with <password>'", createTextEdit("* Log in as user \"john\" with \"secret\"", 2, 0, 2, 36))
+	if len(got) != 3 || !reflect.DeepEqual(got[:1], want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToChangeUnimplementedStepToClosestConcept(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n  * concept 1\n")
+	provider = &dummyInfoProvider{}
+	implementedSteps()
+
+	got := getQuickFixes(t, uri, "a stub for unimplemented step", 2)
+
+	want := wantQuickFix(uri, "Change to 'concept1'", createTextEdit("* concept1", 2, 2, 2, 13))
+	if len(got) != 3 || !reflect.DeepEqual(got[:1], want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestQuickFixToChangeUnimplementedStepWithInlineTable(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* Create users\n   |name|\n   |----|\n   |john|\n")
+	provider = &dummyInfoProvider{}
+	implementedSteps("Create user <table>")
+
+	got := getQuickFixes(t, uri, "a stub for unimplemented step", 2)
+
+	want := wantQuickFix(uri, "Change to 'Create user <table>'", createTextEdit("* Create user", 2, 0, 2, 14))
+	if len(got) != 3 || !reflect.DeepEqual(got[:1], want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestNoQuickFixToChangeUnimplementedStepWithoutSimilarStep(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* Search for products\n")
+	provider = &dummyInfoProvider{}
+	implementedSteps("Log in as user <name>")
+
+	got := getQuickFixes(t, uri, "a stub for unimplemented step", 2)
+
+	if len(got) != 2 || got[0].Command != generateStepCommand {
+		t.Errorf("want only the actions to create a step implementation or concept, got: `%v`", got)
+	}
+}
+
+type applyEditConn struct {
+	MockConn
+	method string
+	params interface{}
+}
+
+func (conn *applyEditConn) Call(ctx context.Context, method string, params, result interface{}, opt ...jsonrpc2.CallOption) error {
+	conn.method, conn.params = method, params
+	return json.Unmarshal([]byte(`{"applied": true}`), result)
+}
+
+func TestChosenQuickFixIsAppliedByClient(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("user_login.spec")
+	openFilesCache.add(uri, "## Scenario\n* step")
+	action := getQuickFixes(t, uri, parser.SpecHeadingNotFound, 0)[0]
+	b, _ := json.Marshal(executeCommandParams{Command: action.Command, Arguments: marshalArgs(t, action.Arguments)})
+	p := json.RawMessage(b)
+	conn := &applyEditConn{}
+
+	_, err := applyQuickFix(context.Background(), conn, &jsonrpc2.Request{Method: "workspace/executeCommand", Params: &p})
+
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	want := applyWorkspaceEditParams{Edit: lsp.WorkspaceEdit{Changes: map[string][]lsp.TextEdit{
+		string(uri): {createTextEdit("# User login\n\n", 0, 0, 0, 0)},
+	}}}
+	if conn.method != "workspace/applyEdit" || !reflect.DeepEqual(conn.params, want) {
+		t.Errorf("want: `workspace/applyEdit %v`,\n got: `%s %v`", want, conn.method, conn.params)
+	}
+}
+
+func marshalArgs(t *testing.T, args []interface{}) []json.RawMessage {
+	var raw []json.RawMessage
+	for _, arg := range args {
+		b, err := json.Marshal(arg)
+		if err != nil {
+			t.Fatalf("Got error %s", err.Error())
+		}
+		raw = append(raw, b)
+	}
+	return raw
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/util"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 	"github.com/sourcegraph/jsonrpc2"
@@ -35,6 +36,47 @@ func stepReferences(req *jsonrpc2.Request) (interface{}, error) {
 	return getLocationFor(params)
 }
 
+func references(req *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.ReferenceParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, fmt.Errorf("failed to parse request %v", err)
+	}
+	uri := params.TextDocument.URI
+	if util.IsConcept(util.ConvertURItoFilePath(uri)) {
+		concepts, _ := new(parser.ConceptParser).Parse(getContent(uri), "")
+		for _, concept := range concepts {
+			if (concept.LineNo - 1) != params.Position.Line {
+				continue
+			}
+			locations, err := getLocationFor(concept.Value)
+			if err != nil || !params.Context.IncludeDeclaration {
+				return locations, err
+			}
+			declaration := lsp.Location{URI: uri, Range: lsp.Range{
+				Start: lsp.Position{Line: params.Position.Line, Character: 0},
+				End:   lsp.Position{Line: params.Position.Line, Character: len(getLine(uri, params.Position.Line))},
+			}}
+			return append([]lsp.Location{declaration}, locations...), nil
+		}
+	}
+	step := stepAt(uri, params.Position.Line)
+	if step == nil {
+		return nil, nil
+	}
+	locations, err := getLocationFor(step.Value)
+	if err != nil || !params.Context.IncludeDeclaration {
+		return locations, err
+	}
+	if concept := provider.SearchConceptDictionary(step.Value); concept != nil {
+		declaration, err := getLspLocationForConcept(concept.FileName, concept.ConceptStep.LineNo)
+		if err != nil {
+			return nil, err
+		}
+		return append([]lsp.Location{declaration.(lsp.Location)}, locations...), nil
+	}
+	return locations, nil
+}
+
 func stepValueAt(req *jsonrpc2.Request) (interface{}, error) {
 	var params lsp.TextDocumentPositionParams
 	if err := json.Unmarshal(*req.Params, &params); err != nil {
@@ -52,7 +94,7 @@ func stepValueAt(req *jsonrpc2.Request) (interface{}, error) {
 	return nil, nil
 }
 
-func getLocationFor(stepValue string) (interface{}, error) {
+func getLocationFor(stepValue string) ([]lsp.Location, error) {
 	allSteps := provider.AllSteps(false)
 	var locations []lsp.Location
 	diskFileCache := &files{cache: make(map[lsp.DocumentURI][]string)}
@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
@@ -97,3 +98,55 @@ func TestStepValueAtShouldGive(t *testing.T) {
 		t.Errorf("want: `%s`,\n got: `%s`", want, stepValue)
 	}
 }
+
+func TestReferencesOfConceptHeadingWithDeclaration(t *testing.T) {
+	provider = &dummyInfoProvider{}
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	specURI := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(specURI, "# Specification Heading\n\n## Scenario Heading\n\n\n\n* Say <hello> to <gauge>")
+	conceptURI := util.ConvertPathToURI("foo.cpt")
+	openFilesCache.add(conceptURI, "# Say <hello> to <gauge>\n* a step")
+
+	b, _ := json.Marshal(lsp.ReferenceParams{
+		TextDocumentPositionParams: lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: conceptURI}, Position: lsp.Position{Line: 0, Character: 4}},
+		Context:                    lsp.ReferenceContext{IncludeDeclaration: true},
+	})
+	p := json.RawMessage(b)
+	got, err := references(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+
+	want := []lsp.Location{
+		{URI: conceptURI, Range: lsp.Range{Start: lsp.Position{Line: 0, Character: 0}, End: lsp.Position{Line: 0, Character: 24}}},
+		{URI: specURI, Range: lsp.Range{Start: lsp.Position{Line: 6, Character: 0}, End: lsp.Position{Line: 6, Character: 24}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestReferencesOfConceptUsageInSpec(t *testing.T) {
+	provider = hoverInfoProvider{concept: &gauge.Concept{FileName: "foo.cpt", ConceptStep: &gauge.Step{Value: "Say {} to {}", LineNo: 1}}}
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	specURI := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(specURI, "# Specification Heading\n\n## Scenario Heading\n\n\n\n* Say <hello> to <gauge>")
+	conceptURI := util.ConvertPathToURI("foo.cpt")
+	openFilesCache.add(conceptURI, "# Say <hello> to <gauge>\n* a step")
+
+	b, _ := json.Marshal(lsp.ReferenceParams{
+		TextDocumentPositionParams: lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: specURI}, Position: lsp.Position{Line: 6, Character: 4}},
+	})
+	p := json.RawMessage(b)
+	got, err := references(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+
+	want := []lsp.Location{
+		{URI: specURI, Range: lsp.Range{Start: lsp.Position{Line: 6, Character: 0}, End: lsp.Position{Line: 6, Character: 24}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
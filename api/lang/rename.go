@@ -36,10 +36,14 @@ func rename(ctx context.Context, conn jsonrpc2.JSONRPC2, req *jsonrpc2.Request)
 	if err := sendSaveFilesRequest(ctx, conn); err != nil {
 		return nil, err
 	}
-	return renameStep(req)
+	progress := beginProgress(ctx, conn, "Renaming", "Finding usages in specifications and concepts")
+	defer progress.end("")
+	return renameStep(ctx, req)
 }
 
-func renameStep(req *jsonrpc2.Request) (interface{}, error) {
+// renameStep gives the changes for renaming a step or a concept. The specs are not parsed any further once the context
+// is done.
+func renameStep(ctx context.Context, req *jsonrpc2.Request) (interface{}, error) {
 	var params lsp.RenameParams
 	var err error
 	if err = json.Unmarshal(*req.Params, &params); err != nil {
@@ -47,6 +51,11 @@ func renameStep(req *jsonrpc2.Request) (interface{}, error) {
 		return nil, err
 	}
 
+	if concept := getConceptHeadingToRename(params); concept != nil {
+		newName := strings.TrimSpace(strings.TrimPrefix(params.NewName, "#"))
+		refactortingResult := refactor.GetConceptRenameChanges(ctx, concept.LineText, newName, provider.GetSpecDirs())
+		return getWorkspaceEdit(req, refactortingResult.Success, refactortingResult.Errors, refactortingResult.Warnings, append(refactortingResult.SpecsChanged, refactortingResult.ConceptsChanged...))
+	}
 	step, err := getStepToRefactor(params)
 
 	if step == nil {
@@ -54,16 +63,20 @@ func renameStep(req *jsonrpc2.Request) (interface{}, error) {
 	}
 	newName := getNewStepName(params, step)
 
-	refactortingResult := refactor.GetRefactoringChanges(step.GetLineText(), newName, lRunner.runner, util.GetSpecDirs())
-	for _, warning := range refactortingResult.Warnings {
+	refactortingResult := refactor.GetRefactoringChanges(ctx, step.GetLineText(), newName, lRunner.runner, provider.GetSpecDirs())
+	changes := append(refactortingResult.SpecsChanged, append(refactortingResult.ConceptsChanged, refactortingResult.RunnerFilesChanged...)...)
+	return getWorkspaceEdit(req, refactortingResult.Success, refactortingResult.Errors, refactortingResult.Warnings, changes)
+}
+
+func getWorkspaceEdit(req *jsonrpc2.Request, success bool, errors, warnings []string, changes []*gm.FileChanges) (interface{}, error) {
+	for _, warning := range warnings {
 		logWarning(req, warning)
 	}
-	if !refactortingResult.Success {
-		return nil, fmt.Errorf("%s", strings.Join(refactortingResult.Errors, "\t"))
+	if !success {
+		return nil, fmt.Errorf("%s", strings.Join(errors, "\t"))
 	}
 	var result lsp.WorkspaceEdit
 	result.Changes = make(map[string][]lsp.TextEdit, 0)
-	changes := append(refactortingResult.SpecsChanged, append(refactortingResult.ConceptsChanged, refactortingResult.RunnerFilesChanged...)...)
 	if err := addWorkspaceEdits(&result, changes); err != nil {
 		return nil, err
 	}
@@ -96,6 +109,20 @@ func getStepToRefactor(params lsp.RenameParams) (*gauge.Step, error) {
 	return nil, nil
 }
 
+func getConceptHeadingToRename(params lsp.RenameParams) *gauge.Step {
+	file := util.ConvertURItoFilePath(params.TextDocument.URI)
+	if !util.IsConcept(file) {
+		return nil
+	}
+	concepts, _ := new(parser.ConceptParser).Parse(getContent(params.TextDocument.URI), file)
+	for _, concept := range concepts {
+		if concept.LineNo-1 == params.Position.Line {
+			return concept
+		}
+	}
+	return nil
+}
+
 func getNewStepName(params lsp.RenameParams, step *gauge.Step) string {
 	newName := strings.TrimSpace(strings.TrimPrefix(params.NewName, "*"))
 	if step.HasInlineTable {
@@ -18,6 +18,7 @@
 package lang
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"path/filepath"
@@ -73,7 +74,7 @@ func TestRenameStep(t *testing.T) {
 	b, _ := json.Marshal(renameParams)
 	p := json.RawMessage(b)
 
-	got, err := renameStep(&jsonrpc2.Request{Params: &p})
+	got, err := renameStep(context.Background(), &jsonrpc2.Request{Params: &p})
 	want := lsp.WorkspaceEdit{
 		Changes: map[string][]lsp.TextEdit{
 			string(specURI): []lsp.TextEdit{
@@ -180,7 +181,7 @@ func TestRenameConceptStep(t *testing.T) {
 		},
 	}
 
-	got, err := renameStep(&jsonrpc2.Request{Params: &p})
+	got, err := renameStep(context.Background(), &jsonrpc2.Request{Params: &p})
 
 	if err != nil {
 		t.Fatalf("Got error %s", err.Error())
@@ -192,3 +193,77 @@ func TestRenameConceptStep(t *testing.T) {
 		}
 	}
 }
+
+func TestRenameConceptHeading(t *testing.T) {
+	specText := `# Specification Heading
+
+## Scenario Heading
+
+* Step text
+
+* concept heading
+
+* with a step
+`
+
+	cwd, _ := os.Getwd()
+	specFile := filepath.Join(cwd, "_testdata", "test.spec")
+	conceptFile := filepath.Join(cwd, "_testdata", "some.cpt")
+	specURI := util.ConvertPathToURI(specFile)
+	conceptURI := util.ConvertPathToURI(conceptFile)
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add(specURI, specText)
+	openFilesCache.add(conceptURI, "# concept heading\n* with a step")
+
+	util.GetSpecFiles = func(paths []string) []string {
+		return []string{specFile}
+	}
+	util.GetConceptFiles = func() []string {
+		return []string{conceptFile}
+	}
+	lRunner.runner = nil
+
+	renameParams := lsp.RenameParams{
+		NewName: `# renamed concept heading`,
+		Position: lsp.Position{
+			Line:      0,
+			Character: 3,
+		},
+		TextDocument: lsp.TextDocumentIdentifier{URI: conceptURI},
+	}
+
+	b, _ := json.Marshal(renameParams)
+	p := json.RawMessage(b)
+
+	want := lsp.WorkspaceEdit{
+		Changes: map[string][]lsp.TextEdit{
+			string(specURI): []lsp.TextEdit{
+				lsp.TextEdit{
+					NewText: `* renamed concept heading`,
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 6, Character: 0},
+						End:   lsp.Position{Line: 6, Character: 17},
+					},
+				},
+			},
+			string(conceptURI): []lsp.TextEdit{
+				lsp.TextEdit{
+					NewText: `# renamed concept heading`,
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 0, Character: 0},
+						End:   lsp.Position{Line: 0, Character: 17},
+					},
+				},
+			},
+		},
+	}
+
+	got, err := renameStep(context.Background(), &jsonrpc2.Request{Params: &p})
+
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rename concept heading failed, want: `%v`, got: `%v`", want, got)
+	}
+}
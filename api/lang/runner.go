@@ -39,16 +39,16 @@ type langRunner struct {
 
 var lRunner langRunner
 
-func startRunner() error {
+func startRunner(projectEnv []string) error {
 	var err error
-	err = connectToRunner()
+	err = connectToRunner(projectEnv)
 	if err != nil {
 		return fmt.Errorf("Unable to connect to runner : %s", err.Error())
 	}
 	return nil
 }
 
-func connectToRunner() error {
+func connectToRunner(projectEnv []string) error {
 	logInfo(nil, "Starting language runner")
 	outFile, err := util.OpenFile(logger.ActiveLogFile)
 	if err != nil {
@@ -60,7 +60,7 @@ func connectToRunner() error {
 		return err
 	}
 
-	lRunner.runner, err = runner.ConnectToGrpcRunner(manifest, outFile, config.IdeRequestTimeout())
+	lRunner.runner, err = runner.ConnectToGrpcRunner(manifest, outFile, config.IdeRequestTimeout(), projectEnv)
 	return err
 }
 
@@ -20,9 +20,11 @@ package lang
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"os"
 	"runtime/debug"
+	"sync"
 
 	"github.com/getgauge/gauge/api/infoGatherer"
 	"github.com/getgauge/gauge/execution"
@@ -41,6 +43,8 @@ type infoProvider interface {
 	SearchConceptDictionary(string) *gauge.Concept
 	GetAvailableSpecDetails(specs []string) []*infoGatherer.SpecDetail
 	GetSpecDirs() []string
+	Files() []string
+	Stop()
 }
 
 var provider infoProvider
@@ -53,8 +57,9 @@ type LangHandler struct {
 }
 
 type InitializeParams struct {
-	RootPath     string             `json:"rootPath,omitempty"`
-	Capabilities ClientCapabilities `json:"capabilities,omitempty"`
+	RootPath         string             `json:"rootPath,omitempty"`
+	Capabilities     ClientCapabilities `json:"capabilities,omitempty"`
+	WorkspaceFolders []workspaceFolder  `json:"workspaceFolders,omitempty"`
 }
 
 func newHandler() jsonrpc2.Handler {
@@ -62,34 +67,50 @@ func newHandler() jsonrpc2.Handler {
 }
 
 func (h lspHandler) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.Request) {
-	go h.Handler.Handle(ctx, conn, req)
+	if req.Notif {
+		go h.Handler.Handle(ctx, conn, req)
+		return
+	}
+	ctx = pending.start(ctx, req.ID)
+	go func() {
+		defer pending.done(req.ID)
+		h.Handler.Handle(ctx, conn, req)
+	}()
 }
 
 func (h *LangHandler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.Request) (interface{}, error) {
 	defer recoverPanic(req)
-	return h.Handle(ctx, conn, req)
+	result, err := h.Handle(ctx, conn, req)
+	if cancelled := cancelledError(ctx); cancelled != nil {
+		return nil, cancelled
+	}
+	return result, err
 }
 
 func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *jsonrpc2.Request) (interface{}, error) {
+	defer enterWorkspace(req)()
 	switch req.Method {
 	case "initialize":
-		informRunnerCompatibility(ctx, conn)
 		if err := cacheInitializeParams(req); err != nil {
 			logError(req, err.Error())
 			return nil, err
 		}
 		return gaugeLSPCapabilities(), nil
 	case "initialized":
-		registerFileWatcher(conn, ctx)
+		loadProject(ctx, conn)
+		informRunnerCompatibility(ctx, conn)
 		notifyTelemetry(ctx, conn)
-		err := registerRunnerCapabilities(conn, ctx)
-		if err != nil {
-			logError(req, err.Error())
-		}
+		forEachWorkspace(func() {
+			registerFileWatcher(conn, ctx)
+			if err := registerRunnerCapabilities(conn, ctx); err != nil {
+				logError(req, err.Error())
+			}
+		})
 		go publishDiagnostics(ctx, conn)
 		return nil, nil
 	case "shutdown":
-		killRunner()
+		pending.cancelOthers(req.ID)
+		forEachWorkspace(killRunner)
 		return nil, nil
 	case "exit":
 		if c, ok := conn.(*jsonrpc2.Conn); ok {
@@ -98,7 +119,11 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 		}
 		return nil, nil
 	case "$/cancelRequest":
-		return nil, nil
+		err := cancelRequest(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return nil, err
 	case "textDocument/didOpen":
 		err := documentOpened(req, ctx, conn)
 		if err != nil {
@@ -123,8 +148,14 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			logDebug(req, err.Error())
 		}
 		return nil, err
+	case "workspace/didChangeWorkspaceFolders":
+		err := changeWorkspaceFolders(req, ctx, conn)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return nil, err
 	case "textDocument/completion":
-		val, err := completion(req)
+		val, err := completion(ctx, req)
 		if err != nil {
 			logDebug(req, err.Error())
 		}
@@ -141,6 +172,42 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			logDebug(req, err.Error())
 		}
 		return val, err
+	case "textDocument/references":
+		val, err := references(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
+	case "textDocument/implementation":
+		val, err := implementation(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
+	case "textDocument/foldingRange":
+		val, err := foldingRanges(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
+	case "textDocument/documentHighlight":
+		val, err := documentHighlight(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
+	case "textDocument/hover":
+		val, err := hover(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
+	case "textDocument/signatureHelp":
+		val, err := signatureHelp(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
 	case "textDocument/formatting":
 		data, err := format(req)
 		if err != nil {
@@ -148,6 +215,19 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			showErrorMessageOnClient(ctx, conn, err)
 		}
 		return data, err
+	case "textDocument/rangeFormatting":
+		data, err := rangeFormat(req)
+		if err != nil {
+			logDebug(req, err.Error())
+			showErrorMessageOnClient(ctx, conn, err)
+		}
+		return data, err
+	case "textDocument/onTypeFormatting":
+		data, err := onTypeFormat(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return data, err
 	case "textDocument/codeLens":
 		val, err := codeLenses(req)
 		if err != nil {
@@ -160,6 +240,13 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			logDebug(req, err.Error())
 		}
 		return val, err
+	case "workspace/executeCommand":
+		val, err := applyQuickFix(ctx, conn, req)
+		if err != nil {
+			logDebug(req, err.Error())
+			showErrorMessageOnClient(ctx, conn, err)
+		}
+		return val, err
 	case "textDocument/rename":
 		result, err := rename(ctx, conn, req)
 		if err != nil {
@@ -233,6 +320,12 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			return nil, err
 		}
 		return generateConcept(req)
+	case "gauge/inlineConcept":
+		if err := sendSaveFilesRequest(ctx, conn); err != nil {
+			showErrorMessageOnClient(ctx, conn, err)
+			return nil, err
+		}
+		return inlineConcept(req)
 	case "gauge/getRunnerLanguage":
 		return lRunner.lspID, nil
 	case "gauge/specDirs":
@@ -249,6 +342,7 @@ func cacheInitializeParams(req *jsonrpc2.Request) error {
 		return err
 	}
 	clientCapabilities = params.Capabilities
+	initialWorkspaceFolders = params.WorkspaceFolders
 	return nil
 }
 
@@ -262,23 +356,43 @@ func startLsp(logLevel string) (context.Context, *jsonrpc2.Conn) {
 	return ctx, jsonrpc2.NewConn(ctx, jsonrpc2.NewBufferedStream(stdRWC{}, jsonrpc2.VSCodeObjectCodec{}), newHandler(), connOpt...)
 }
 
-func initializeRunner() {
+func initializeRunner(projectEnv []string) {
 	id, err := getLanguageIdentifier()
 	if err != nil || id == "" {
 		logDebug(nil, "Current runner is not compatible with gauge LSP.")
 		return
 	}
-	err = startRunner()
+	err = startRunner(projectEnv)
 	if err != nil {
 		logDebug(nil, "%s\nSome of the gauge lsp feature will not work as expected.", err.Error())
 	}
 	lRunner.lspID = id
 }
 
+// projectLoaded is done once the project is loaded, after the client is initialized.
+var projectLoaded sync.WaitGroup
+
+// loadProject reads the specs and concepts and starts the runner of each workspace folder, and reports the progress to
+// the client.
+func loadProject(ctx context.Context, conn jsonrpc2.JSONRPC2) {
+	defer projectLoaded.Done()
+	progress := beginProgress(ctx, conn, "Loading gauge project", "Loading specifications")
+	provider.Init()
+	progress.report("Starting runner")
+	initializeRunner(nil)
+	workspaceLock.Lock()
+	workspaces = []*workspace{currentWorkspace()}
+	workspaceLock.Unlock()
+	for _, folder := range initialWorkspaceFolders {
+		progress.report(fmt.Sprintf("Loading %s", folder.Name))
+		addWorkspaceFolders([]workspaceFolder{folder})
+	}
+	progress.end("Gauge project loaded")
+}
+
 func Start(p infoProvider, logLevel string) {
 	provider = p
-	provider.Init()
-	initializeRunner()
+	projectLoaded.Add(1)
 	ctx, conn := startLsp(logLevel)
 	initialize(ctx, conn)
 	<-conn.DisconnectNotify()
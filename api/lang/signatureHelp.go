@@ -0,0 +1,166 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+func signatureHelp(req *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.TextDocumentPositionParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, err
+	}
+	logDebug(req, "LangServer: request received : Type: Signature Help URI: %s", params.TextDocument.URI)
+	line := getLine(params.TextDocument.URI, params.Position.Line)
+	if !strings.HasPrefix(strings.TrimSpace(line), "*") {
+		return nil, nil
+	}
+	stepValue, err := stepValueOf(line)
+	if err != nil {
+		return nil, nil
+	}
+	signature, err := stepSignature(stepValue)
+	if err != nil {
+		return nil, err
+	}
+	if signature == nil {
+		if signature, err = partialStepSignature(stepValue); signature == nil || err != nil {
+			return nil, err
+		}
+	}
+	active := activeParameter(line, params.Position.Character)
+	if active >= len(signature.Parameters) {
+		active = len(signature.Parameters) - 1
+	}
+	return lsp.SignatureHelp{Signatures: []lsp.SignatureInformation{*signature}, ActiveParameter: active}, nil
+}
+
+// stepValueOf gives the value of the step in the line. An argument which is still being typed is closed, so that the
+// step can be read.
+func stepValueOf(line string) (*gauge.StepValue, error) {
+	text := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+	stepValue, err := parser.ExtractStepValueAndParams(text, false)
+	for _, closing := range []string{"\"", ">"} {
+		if err == nil {
+			break
+		}
+		stepValue, err = parser.ExtractStepValueAndParams(text+closing, false)
+	}
+	return stepValue, err
+}
+
+// stepSignature gives the parameters of the concept with the step value, or else of the step implementation.
+func stepSignature(stepValue *gauge.StepValue) (*lsp.SignatureInformation, error) {
+	if len(stepValue.Args) == 0 {
+		return nil, nil
+	}
+	if concept := provider.SearchConceptDictionary(stepValue.StepValue); concept != nil {
+		var names []string
+		for _, arg := range concept.ConceptStep.Args {
+			names = append(names, arg.Value)
+		}
+		doc := fmt.Sprintf("Concept defined in %s:%d", util.RelPathToProjectRoot(concept.FileName), concept.ConceptStep.LineNo)
+		return newSignature(stepValue.StepValue, names, doc), nil
+	}
+	if lRunner.runner == nil {
+		return nil, nil
+	}
+	res, err := getStepNameResponse(stepValue.StepValue)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || !res.GetIsStepPresent() || len(res.GetStepName()) == 0 {
+		return nil, nil
+	}
+	var names []string
+	for _, match := range paramPattern.FindAllStringSubmatch(res.GetStepName()[0], -1) {
+		names = append(names, match[1])
+	}
+	doc := fmt.Sprintf("Implemented in %s:%d", util.RelPathToProjectRoot(res.GetFileName()), res.GetSpan().GetStart())
+	return newSignature(stepValue.StepValue, names, doc), nil
+}
+
+// partialStepSignature gives the signature of the shortest implemented step or concept which starts with the step
+// value, as the rest of the step may not be typed yet.
+func partialStepSignature(stepValue *gauge.StepValue) (*lsp.SignatureInformation, error) {
+	if len(stepValue.Args) == 0 {
+		return nil, nil
+	}
+	var match *gauge.StepValue
+	for _, text := range implementedStepTexts() {
+		value, err := parser.ExtractStepValueAndParams(text, false)
+		if err != nil || value.StepValue == stepValue.StepValue || !strings.HasPrefix(value.StepValue, stepValue.StepValue) {
+			continue
+		}
+		if match == nil || len(value.StepValue) < len(match.StepValue) || (len(value.StepValue) == len(match.StepValue) && value.StepValue < match.StepValue) {
+			match = value
+		}
+	}
+	if match == nil {
+		return nil, nil
+	}
+	return stepSignature(match)
+}
+
+func newSignature(stepValue string, names []string, doc string) *lsp.SignatureInformation {
+	signature := &lsp.SignatureInformation{Label: stepValue, Documentation: doc}
+	for _, name := range names {
+		param := fmt.Sprintf("<%s>", name)
+		signature.Label = strings.Replace(signature.Label, gauge.ParameterPlaceholder, param, 1)
+		signature.Parameters = append(signature.Parameters, lsp.ParameterInformation{Label: param})
+	}
+	if len(signature.Parameters) == 0 {
+		return nil
+	}
+	return signature
+}
+
+// activeParameter gives the index of the argument at the character of the step line. A character after an argument
+// is taken to be at the next argument.
+func activeParameter(line string, character int) int {
+	index := 0
+	inQuotes, inBrackets, escaped := false, false, false
+	for i, c := range line {
+		if i >= character {
+			break
+		}
+		switch {
+		case escaped:
+			escaped = false
+		case inQuotes && c == '\\':
+			escaped = true
+		case inQuotes && c == '"', inBrackets && c == '>':
+			inQuotes, inBrackets = false, false
+			index++
+		case !inQuotes && !inBrackets && c == '"':
+			inQuotes = true
+		case !inQuotes && !inBrackets && c == '<':
+			inBrackets = true
+		}
+	}
+	return index
+}
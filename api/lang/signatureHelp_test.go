@@ -0,0 +1,165 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+	"google.golang.org/grpc"
+)
+
+func getSignatureHelp(t *testing.T, uri lsp.DocumentURI, position lsp.Position) interface{} {
+	b, _ := json.Marshal(lsp.TextDocumentPositionParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}, Position: position})
+	p := json.RawMessage(b)
+	got, err := signatureHelp(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Expected error == nil in signatureHelp, got %s", err.Error())
+	}
+	return got
+}
+
+func TestSignatureHelpForImplementedStep(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	line := `* Create user "bob" with role "" in org "acme"`
+	openFilesCache.add(uri, "# Spec\n## Scenario\n"+line+"\n")
+	provider = hoverInfoProvider{}
+	responses := map[gm.Message_MessageType]interface{}{}
+	responses[gm.Message_StepNameResponse] = &gm.StepNameResponse{
+		IsStepPresent: true,
+		StepName:      []string{"Create user <name> with role <role> in org <org>"},
+		FileName:      "step_impl.js",
+		Span:          &gm.Span{Start: 3},
+	}
+	lRunner.runner = &runner.GrpcRunner{Client: &mockLspClient{responses: responses}, Timeout: time.Second * 30}
+	defer func() { lRunner.runner = nil }()
+
+	want := lsp.SignatureHelp{
+		Signatures: []lsp.SignatureInformation{{
+			Label:         "Create user <name> with role <role> in org <org>",
+			Documentation: "Implemented in step_impl.js:3",
+			Parameters:    []lsp.ParameterInformation{{Label: "<name>"}, {Label: "<role>"}, {Label: "<org>"}},
+		}},
+		ActiveParameter: 1,
+	}
+	got := getSignatureHelp(t, uri, lsp.Position{Line: 2, Character: len(`* Create user "bob" with role "`)})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestSignatureHelpForConceptWhileTypingArgument(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	openFilesCache.add(uri, "# Spec\n## Scenario\n* login as \"admin\" with \"\n")
+	provider = hoverInfoProvider{concept: &gauge.Concept{FileName: "login.cpt", ConceptStep: &gauge.Step{
+		Value:  "login as {} with {}",
+		LineNo: 4,
+		Args:   []*gauge.StepArg{{Name: "user", Value: "user", ArgType: gauge.Dynamic}, {Name: "password", Value: "password", ArgType: gauge.Dynamic}},
+	}}}
+
+	want := lsp.SignatureHelp{
+		Signatures: []lsp.SignatureInformation{{
+			Label:         "login as <user> with <password>",
+			Documentation: "Concept defined in login.cpt:4",
+			Parameters:    []lsp.ParameterInformation{{Label: "<user>"}, {Label: "<password>"}},
+		}},
+		ActiveParameter: 1,
+	}
+	got := getSignatureHelp(t, uri, lsp.Position{Line: 2, Character: len(`* login as "admin" with "`)})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+type stepNameClient struct {
+	mockLspClient
+	steps map[string]*gm.StepNameResponse
+}
+
+func (c *stepNameClient) GetStepName(ctx context.Context, in *gm.StepNameRequest, opts ...grpc.CallOption) (*gm.StepNameResponse, error) {
+	if res, ok := c.steps[in.GetStepValue()]; ok {
+		return res, nil
+	}
+	return &gm.StepNameResponse{}, nil
+}
+
+func TestSignatureHelpForPartiallyTypedStep(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	uri := util.ConvertPathToURI("foo.spec")
+	line := `* Create user "john" with role "`
+	openFilesCache.add(uri, "# Spec\n## Scenario\n"+line+"\n")
+	provider = hoverInfoProvider{}
+	responses := map[gm.Message_MessageType]interface{}{}
+	responses[gm.Message_StepNamesResponse] = &gm.StepNamesResponse{Steps: []string{
+		"Create user <name> with role <role> in org <org> as <state>",
+		"Create user <name> with role <role> in org <org>",
+		"Delete user <name>",
+	}}
+	client := &stepNameClient{mockLspClient: mockLspClient{responses: responses}, steps: map[string]*gm.StepNameResponse{
+		"Create user {} with role {} in org {}": {
+			IsStepPresent: true,
+			StepName:      []string{"Create user <name> with role <role> in org <org>"},
+			FileName:      "step_impl.js",
+			Span:          &gm.Span{Start: 3},
+		},
+	}}
+	lRunner.runner = &runner.GrpcRunner{Client: client, Timeout: time.Second * 30}
+	defer func() { lRunner.runner = nil }()
+
+	want := lsp.SignatureHelp{
+		Signatures: []lsp.SignatureInformation{{
+			Label:         "Create user <name> with role <role> in org <org>",
+			Documentation: "Implemented in step_impl.js:3",
+			Parameters:    []lsp.ParameterInformation{{Label: "<name>"}, {Label: "<role>"}, {Label: "<org>"}},
+		}},
+		ActiveParameter: 1,
+	}
+	got := getSignatureHelp(t, uri, lsp.Position{Line: 2, Character: len(line)})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, got)
+	}
+}
+
+func TestActiveParameter(t *testing.T) {
+	line := `* say "a \" b" to <name> and "c"`
+	tests := []struct {
+		character int
+		want      int
+	}{
+		{character: len(`* say`), want: 0},
+		{character: len(`* say "a \"`), want: 0},
+		{character: len(`* say "a \" b" to <na`), want: 1},
+		{character: len(`* say "a \" b" to <name> and `), want: 2},
+	}
+	for _, test := range tests {
+		if got := activeParameter(line, test.character); got != test.want {
+			t.Errorf("activeParameter at %d, want: %d, got: %d", test.character, test.want, got)
+		}
+	}
+}
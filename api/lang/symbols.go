@@ -69,7 +69,7 @@ func workspaceSymbols(req *jsonrpc2.Request) (interface{}, error) {
 
 	var specSymbols = make([]*lsp.SymbolInformation, 0)
 	var scnSymbols = make([]*lsp.SymbolInformation, 0)
-	specDetails := provider.GetAvailableSpecDetails([]string{})
+	specDetails := provider.GetAvailableSpecDetails(provider.GetSpecDirs())
 	for _, specDetail := range specDetails {
 		if !specDetail.HasSpec() {
 			continue
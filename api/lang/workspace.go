@@ -0,0 +1,307 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/api/infoGatherer"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type workspaceFolder struct {
+	URI  lsp.DocumentURI `json:"uri"`
+	Name string          `json:"name"`
+}
+
+type didChangeWorkspaceFoldersParams struct {
+	Event workspaceFoldersChangeEvent `json:"event"`
+}
+
+type workspaceFoldersChangeEvent struct {
+	Added   []workspaceFolder `json:"added"`
+	Removed []workspaceFolder `json:"removed"`
+}
+
+// workspace is a gauge project opened as a workspace folder. Each workspace has its own project root, info provider,
+// diagnostics cache and runner connection, which are made the active ones before a request about one of its
+// documents is handled. The env vars of a workspace added after the server has started are read from its own project,
+// and its runner is started with them.
+type workspace struct {
+	root        string
+	env         []string
+	provider    infoProvider
+	runner      langRunner
+	diagnostics *diagnosticsCache
+}
+
+var workspaces []*workspace
+
+// workspaceLock guards the active workspace. Requests are handled concurrently when there is only one workspace, and
+// one at a time when there are several, since the active workspace changes with the document of the request.
+var workspaceLock sync.RWMutex
+
+// newProvider gives the info provider of a workspace folder which is added after the server has started.
+var newProvider = func(specDirs []string) infoProvider {
+	return &infoGatherer.SpecInfoGatherer{SpecDirs: specDirs}
+}
+
+// lifecycleMethods are the requests which are handled without waiting for the project to be loaded, and which take
+// the workspace lock themselves.
+var lifecycleMethods = map[string]bool{
+	"initialize":                          true,
+	"initialized":                         true,
+	"shutdown":                            true,
+	"exit":                                true,
+	"$/cancelRequest":                     true,
+	"workspace/didChangeWorkspaceFolders": true,
+}
+
+// initialWorkspaceFolders are the workspace folders the client is started with, which are loaded once it is initialized.
+var initialWorkspaceFolders []workspaceFolder
+
+// documentParams holds the document of a request, which is either a text document or a single uri.
+type documentParams struct {
+	TextDocument lsp.TextDocumentIdentifier `json:"textDocument"`
+	URI          lsp.DocumentURI            `json:"uri"`
+}
+
+// currentWorkspace gives the workspace made of the active project root, provider, runner and diagnostics cache.
+func currentWorkspace() *workspace {
+	return &workspace{root: config.ProjectRoot, provider: provider, runner: lRunner, diagnostics: cachedDiagnostics}
+}
+
+func (w *workspace) activate() {
+	if config.ProjectRoot != w.root {
+		config.ProjectRoot = w.root
+		if err := os.Chdir(w.root); err != nil {
+			logDebug(nil, "Unable to change directory to %s. %s", w.root, err.Error())
+		}
+	}
+	provider = w.provider
+	lRunner = w.runner
+	cachedDiagnostics = w.diagnostics
+}
+
+func (w *workspace) contains(path string) bool {
+	rel, err := filepath.Rel(w.root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// workspaceFor gives the innermost workspace which contains the document, or nil if there is none.
+func workspaceFor(uri lsp.DocumentURI) *workspace {
+	path := util.ConvertURItoFilePath(uri)
+	var found *workspace
+	for _, w := range workspaces {
+		if w.contains(path) && (found == nil || len(w.root) > len(found.root)) {
+			found = w
+		}
+	}
+	return found
+}
+
+func activateWorkspaceOf(uri lsp.DocumentURI) {
+	if len(workspaces) <= 1 {
+		return
+	}
+	if w := workspaceFor(uri); w != nil {
+		w.activate()
+	}
+}
+
+// enterWorkspace activates the workspace of the document in the request and gives the function which has to be
+// called once the request is handled.
+func enterWorkspace(req *jsonrpc2.Request) func() {
+	if lifecycleMethods[req.Method] {
+		return func() {}
+	}
+	projectLoaded.Wait()
+	workspaceLock.RLock()
+	if len(workspaces) <= 1 {
+		return workspaceLock.RUnlock
+	}
+	workspaceLock.RUnlock()
+	workspaceLock.Lock()
+	activateWorkspaceOf(requestURI(req))
+	return workspaceLock.Unlock
+}
+
+func requestURI(req *jsonrpc2.Request) lsp.DocumentURI {
+	var params documentParams
+	if req.Params == nil || json.Unmarshal(*req.Params, &params) != nil {
+		return ""
+	}
+	if params.TextDocument.URI != "" {
+		return params.TextDocument.URI
+	}
+	return params.URI
+}
+
+// forEachWorkspace calls f with each workspace activated in turn.
+func forEachWorkspace(f func()) {
+	workspaceLock.RLock()
+	if len(workspaces) <= 1 {
+		defer workspaceLock.RUnlock()
+		f()
+		return
+	}
+	all := append([]*workspace{}, workspaces...)
+	workspaceLock.RUnlock()
+	for _, w := range all {
+		workspaceLock.Lock()
+		if indexOfWorkspace(w.root) != -1 {
+			w.activate()
+			f()
+		}
+		workspaceLock.Unlock()
+	}
+}
+
+func indexOfWorkspace(root string) int {
+	for i, w := range workspaces {
+		if w.root == root {
+			return i
+		}
+	}
+	return -1
+}
+
+// addWorkspaceFolders adds the folders which are gauge projects, and gives the workspaces which were added.
+func addWorkspaceFolders(folders []workspaceFolder) []*workspace {
+	workspaceLock.Lock()
+	defer workspaceLock.Unlock()
+	var added []*workspace
+	for _, folder := range folders {
+		root := filepath.Clean(util.ConvertURItoFilePath(folder.URI))
+		if indexOfWorkspace(root) != -1 || !common.FileExists(filepath.Join(root, common.ManifestFile)) {
+			continue
+		}
+		added = append(added, addWorkspace(root))
+	}
+	return added
+}
+
+// addWorkspace initializes the provider and runner of a gauge project. The spec directories of the project are read
+// from the environments of the project which the server was started with.
+func addWorkspace(root string) *workspace {
+	active := currentWorkspace()
+	defer active.activate()
+	vars, err := env.ProjectEnv(root, env.CurrentEnvironments())
+	if err != nil {
+		logDebug(nil, "Unable to load env of %s. %s", root, err.Error())
+	}
+	w := &workspace{root: root, env: projectEnv(root, vars), diagnostics: newDiagnosticsCache()}
+	w.activate()
+	provider = newProvider(util.SpecDirsOf(vars[env.SpecsDir]))
+	provider.Init()
+	lRunner = langRunner{}
+	initializeRunner(w.env)
+	w.provider, w.runner = provider, lRunner
+	workspaces = append(workspaces, w)
+	return w
+}
+
+// projectEnv gives the env vars of a project, along with its project root, as the environment of its runner.
+func projectEnv(root string, vars map[string]string) []string {
+	projectEnv := []string{common.GaugeProjectRootEnv + "=" + root}
+	for name, value := range vars {
+		projectEnv = append(projectEnv, name+"="+value)
+	}
+	return projectEnv
+}
+
+// removeWorkspaceFolders removes the given folders, except the last remaining one, and gives the removed workspaces.
+func removeWorkspaceFolders(folders []workspaceFolder) []*workspace {
+	workspaceLock.Lock()
+	defer workspaceLock.Unlock()
+	var removed []*workspace
+	for _, folder := range folders {
+		i := indexOfWorkspace(filepath.Clean(util.ConvertURItoFilePath(folder.URI)))
+		if i == -1 || len(workspaces) == 1 {
+			continue
+		}
+		w := workspaces[i]
+		workspaces = append(workspaces[:i], workspaces[i+1:]...)
+		w.provider.Stop()
+		if w.runner.runner != nil {
+			w.runner.runner.Kill()
+		}
+		removed = append(removed, w)
+	}
+	if len(removed) > 0 {
+		workspaces[0].activate()
+	}
+	return removed
+}
+
+func changeWorkspaceFolders(req *jsonrpc2.Request, ctx context.Context, conn jsonrpc2.JSONRPC2) error {
+	var params didChangeWorkspaceFoldersParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return fmt.Errorf("failed to parse request. %s", err.Error())
+	}
+	projectLoaded.Wait()
+	for _, w := range removeWorkspaceFolders(params.Event.Removed) {
+		for uri := range w.diagnostics.published {
+			publishDiagnostic(uri, []lsp.Diagnostic{}, conn, ctx)
+		}
+	}
+	added := addWorkspaceFolders(params.Event.Added)
+	if len(added) == 0 {
+		return nil
+	}
+	forEachWorkspace(func() {
+		if !isAdded(added) {
+			return
+		}
+		registerFileWatcher(conn, ctx)
+		if err := registerRunnerCapabilities(conn, ctx); err != nil {
+			logDebug(req, err.Error())
+		}
+	})
+	go publishDiagnostics(ctx, conn)
+	return nil
+}
+
+// isAdded tells whether the active workspace is one of the given workspaces.
+func isAdded(added []*workspace) bool {
+	for _, w := range added {
+		if w.root == config.ProjectRoot {
+			return true
+		}
+	}
+	return false
+}
+
+// registrationID makes the id of a capability registration unique to the active workspace when there are several.
+func registrationID(id string) string {
+	if len(workspaces) <= 1 || workspaces[0].root == config.ProjectRoot {
+		return id
+	}
+	return id + "-" + config.ProjectRoot
+}
@@ -0,0 +1,285 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type initCountingProvider struct {
+	dummyInfoProvider
+	initCount int
+	specDirs  []string
+	stopped   bool
+}
+
+func (p *initCountingProvider) Init() {
+	p.initCount++
+}
+
+func (p *initCountingProvider) Stop() {
+	p.stopped = true
+}
+
+func (p *initCountingProvider) GetSpecDirs() []string {
+	return p.specDirs
+}
+
+// withWorkspaces runs the test with the given workspaces, and restores the active workspace afterwards.
+func withWorkspaces(t *testing.T, ws []*workspace, test func()) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	active := currentWorkspace()
+	oldWorkspaces := workspaces
+	workspaces = ws
+	defer func() {
+		workspaces = oldWorkspaces
+		active.activate()
+		os.Chdir(cwd)
+	}()
+	test()
+}
+
+func createProjects(t *testing.T, names ...string) string {
+	dir, err := ioutil.TempDir("", "gaugeWorkspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range names {
+		if err := os.MkdirAll(filepath.Join(dir, name), common.NewDirectoryPermissions); err != nil {
+			t.Fatal(err)
+		}
+		manifest := []byte(`{"Language": "unknown", "Plugins": []}`)
+		if err := ioutil.WriteFile(filepath.Join(dir, name, common.ManifestFile), manifest, common.NewFilePermissions); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestWorkspaceForGivesInnermostWorkspaceOfDocument(t *testing.T) {
+	outer := &workspace{root: filepath.Join("home", "project")}
+	inner := &workspace{root: filepath.Join("home", "project", "nested")}
+	other := &workspace{root: filepath.Join("home", "project2")}
+	withWorkspaces(t, []*workspace{outer, inner, other}, func() {
+		tests := []struct {
+			path string
+			want *workspace
+		}{
+			{filepath.Join("home", "project", "specs", "a.spec"), outer},
+			{filepath.Join("home", "project", "nested", "specs", "a.spec"), inner},
+			{filepath.Join("home", "project2", "specs", "a.spec"), other},
+			{filepath.Join("home", "project3", "specs", "a.spec"), nil},
+		}
+		for _, test := range tests {
+			got := workspaceFor(util.ConvertPathToURI(test.path))
+			if got != test.want {
+				t.Errorf("want workspace for %s: `%v`,\n got: `%v`", test.path, test.want, got)
+			}
+		}
+	})
+}
+
+func TestEnterWorkspaceActivatesWorkspaceOfDocument(t *testing.T) {
+	dir := createProjects(t, "a", "b")
+	defer os.RemoveAll(dir)
+	a := &workspace{root: filepath.Join(dir, "a"), provider: &initCountingProvider{}, diagnostics: newDiagnosticsCache()}
+	b := &workspace{root: filepath.Join(dir, "b"), provider: &initCountingProvider{}, diagnostics: newDiagnosticsCache()}
+	withWorkspaces(t, []*workspace{a, b}, func() {
+		a.activate()
+		params, _ := json.Marshal(lsp.TextDocumentPositionParams{
+			TextDocument: lsp.TextDocumentIdentifier{URI: util.ConvertPathToURI(filepath.Join(b.root, "specs", "example.spec"))},
+		})
+		p := json.RawMessage(params)
+
+		leave := enterWorkspace(&jsonrpc2.Request{Method: "textDocument/hover", Params: &p})
+		defer leave()
+
+		if config.ProjectRoot != b.root {
+			t.Errorf("want project root: `%s`,\n got: `%s`", b.root, config.ProjectRoot)
+		}
+		if provider != b.provider || cachedDiagnostics != b.diagnostics {
+			t.Errorf("want provider and diagnostics cache of workspace %s to be active", b.root)
+		}
+	})
+}
+
+func TestAddWorkspaceFoldersAddsGaugeProjects(t *testing.T) {
+	dir := createProjects(t, "a", "b")
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(filepath.Join(dir, "notAProject"), common.NewDirectoryPermissions); err != nil {
+		t.Fatal(err)
+	}
+	oldNewProvider := newProvider
+	defer func() { newProvider = oldNewProvider }()
+	newProvider = func(specDirs []string) infoProvider {
+		return &initCountingProvider{}
+	}
+	first := &workspace{root: filepath.Join(dir, "a"), provider: &initCountingProvider{}, diagnostics: newDiagnosticsCache()}
+	withWorkspaces(t, []*workspace{first}, func() {
+		first.activate()
+		added := addWorkspaceFolders([]workspaceFolder{
+			{URI: util.ConvertPathToURI(filepath.Join(dir, "a"))},
+			{URI: util.ConvertPathToURI(filepath.Join(dir, "b"))},
+			{URI: util.ConvertPathToURI(filepath.Join(dir, "notAProject"))},
+		})
+
+		if len(added) != 1 || added[0].root != filepath.Join(dir, "b") {
+			t.Fatalf("want only workspace %s to be added, got: `%v`", filepath.Join(dir, "b"), added)
+		}
+		if got := added[0].provider.(*initCountingProvider).initCount; got != 1 {
+			t.Errorf("want provider of added workspace to be initialized once, got: `%d`", got)
+		}
+		if added[0].diagnostics == first.diagnostics {
+			t.Errorf("want added workspace to have its own diagnostics cache")
+		}
+		if len(workspaces) != 2 {
+			t.Errorf("want: `2` workspaces,\n got: `%d`", len(workspaces))
+		}
+		if config.ProjectRoot != first.root || provider != first.provider {
+			t.Errorf("want workspace %s to remain active, got: `%s`", first.root, config.ProjectRoot)
+		}
+	})
+}
+
+func TestRemoveWorkspaceFoldersKeepsLastWorkspace(t *testing.T) {
+	dir := createProjects(t, "a", "b")
+	defer os.RemoveAll(dir)
+	a := &workspace{root: filepath.Join(dir, "a"), provider: &initCountingProvider{}, diagnostics: newDiagnosticsCache()}
+	b := &workspace{root: filepath.Join(dir, "b"), provider: &initCountingProvider{}, diagnostics: newDiagnosticsCache()}
+	withWorkspaces(t, []*workspace{a, b}, func() {
+		b.activate()
+		removed := removeWorkspaceFolders([]workspaceFolder{
+			{URI: util.ConvertPathToURI(a.root)},
+			{URI: util.ConvertPathToURI(b.root)},
+		})
+
+		if len(removed) != 1 || removed[0] != a {
+			t.Errorf("want only workspace %s to be removed, got: `%v`", a.root, removed)
+		}
+		if len(workspaces) != 1 || workspaces[0] != b {
+			t.Errorf("want workspace %s to remain, got: `%v`", b.root, workspaces)
+		}
+		if config.ProjectRoot != b.root {
+			t.Errorf("want project root: `%s`,\n got: `%s`", b.root, config.ProjectRoot)
+		}
+		if !a.provider.(*initCountingProvider).stopped || b.provider.(*initCountingProvider).stopped {
+			t.Errorf("want only provider of removed workspace %s to be stopped", a.root)
+		}
+	})
+}
+
+func TestAddWorkspaceFoldersReadsEnvOfProject(t *testing.T) {
+	dir := createProjects(t, "a", "b")
+	defer os.RemoveAll(dir)
+	envDir := filepath.Join(dir, "b", common.EnvDirectoryName, common.DefaultEnvDir)
+	if err := os.MkdirAll(envDir, common.NewDirectoryPermissions); err != nil {
+		t.Fatal(err)
+	}
+	properties := []byte("gauge_specs_dir = acceptance, smoke\n")
+	if err := ioutil.WriteFile(filepath.Join(envDir, "default.properties"), properties, common.NewFilePermissions); err != nil {
+		t.Fatal(err)
+	}
+	oldNewProvider := newProvider
+	defer func() { newProvider = oldNewProvider }()
+	newProvider = func(specDirs []string) infoProvider {
+		return &initCountingProvider{specDirs: specDirs}
+	}
+	first := &workspace{root: filepath.Join(dir, "a"), provider: &initCountingProvider{}, diagnostics: newDiagnosticsCache()}
+	withWorkspaces(t, []*workspace{first}, func() {
+		first.activate()
+		added := addWorkspaceFolders([]workspaceFolder{{URI: util.ConvertPathToURI(filepath.Join(dir, "b"))}})
+
+		if len(added) != 1 {
+			t.Fatalf("want workspace %s to be added, got: `%v`", filepath.Join(dir, "b"), added)
+		}
+		want := []string{"acceptance", "smoke"}
+		if got := added[0].provider.(*initCountingProvider).specDirs; !reflect.DeepEqual(got, want) {
+			t.Errorf("want spec dirs: `%v`,\n got: `%v`", want, got)
+		}
+		wantEnv := []string{common.GaugeProjectRootEnv + "=" + filepath.Join(dir, "b"), "gauge_specs_dir=acceptance, smoke"}
+		for _, e := range wantEnv {
+			if !util.ListContains(added[0].env, e) {
+				t.Errorf("want env of added workspace to contain: `%s`,\n got: `%v`", e, added[0].env)
+			}
+		}
+	})
+}
+
+func TestDiagnosticsOfWorkspaceAreFromItsSpecDirs(t *testing.T) {
+	dir := createProjects(t, "a", "b")
+	defer os.RemoveAll(dir)
+	envDir := filepath.Join(dir, "b", common.EnvDirectoryName, common.DefaultEnvDir)
+	if err := os.MkdirAll(envDir, common.NewDirectoryPermissions); err != nil {
+		t.Fatal(err)
+	}
+	properties := []byte("gauge_specs_dir = acceptance\n")
+	if err := ioutil.WriteFile(filepath.Join(envDir, "default.properties"), properties, common.NewFilePermissions); err != nil {
+		t.Fatal(err)
+	}
+	oldNewProvider, oldGetSpecFiles, oldGetConceptFiles := newProvider, util.GetSpecFiles, util.GetConceptFiles
+	defer func() {
+		newProvider, util.GetSpecFiles, util.GetConceptFiles = oldNewProvider, oldGetSpecFiles, oldGetConceptFiles
+	}()
+	newProvider = func(specDirs []string) infoProvider {
+		return &initCountingProvider{specDirs: specDirs}
+	}
+	var scanned []string
+	util.GetSpecFiles = func(paths []string) []string {
+		scanned = append(scanned, paths...)
+		return nil
+	}
+	util.GetConceptFiles = func() []string {
+		return nil
+	}
+	first := &workspace{root: filepath.Join(dir, "a"), provider: &initCountingProvider{specDirs: []string{"specs"}}, diagnostics: newDiagnosticsCache()}
+	withWorkspaces(t, []*workspace{first}, func() {
+		first.activate()
+		addWorkspaceFolders([]workspaceFolder{{URI: util.ConvertPathToURI(filepath.Join(dir, "b"))}})
+		params, _ := json.Marshal(lsp.TextDocumentPositionParams{
+			TextDocument: lsp.TextDocumentIdentifier{URI: util.ConvertPathToURI(filepath.Join(dir, "b", "acceptance", "example.spec"))},
+		})
+		p := json.RawMessage(params)
+
+		leave := enterWorkspace(&jsonrpc2.Request{Method: "textDocument/hover", Params: &p})
+		_, err := getDiagnostics(context.Background())
+		leave()
+
+		if err != nil {
+			t.Fatalf("want no error, got: `%s`", err.Error())
+		}
+		want := []string{"acceptance"}
+		if !reflect.DeepEqual(scanned, want) {
+			t.Errorf("want spec dirs: `%v`,\n got: `%v`", want, scanned)
+		}
+	})
+}
@@ -182,7 +182,10 @@ func initPackageFlags() {
 	reporter.SimpleConsoleOutput = simpleConsole
 	reporter.Verbose = verbose
 	reporter.MachineReadable = machineReadable
+	reporter.EventLogFile = eventLog
 	execution.MachineReadable = machineReadable
+	execution.PrintSummary = summary
+	execution.SaveSummary = summaryJSON
 	execution.ExecuteTags = tags
 	execution.SetTableRows(rows)
 	validation.TableRows = rows
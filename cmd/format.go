@@ -24,20 +24,28 @@ import (
 )
 
 var formatCmd = &cobra.Command{
-	Use:     "format [flags] [args]",
-	Short:   "Formats the specified spec files",
-	Long:    `Formats the specified spec files.`,
-	Example: "  gauge format specs/",
+	Use:   "format [flags] [args]",
+	Short: "Formats the specified spec and concept files",
+	Long:  `Formats the specified spec and concept files.`,
+	Example: `  gauge format specs/
+  gauge format --check specs/`,
 	Run: func(cmd *cobra.Command, args []string) {
 		loadEnvAndInitLogger(cmd)
 		if err := config.SetProjectRoot(args); err != nil {
 			exit(err, cmd.UsageString())
 		}
+		if checkFormat {
+			formatter.CheckSpecFilesIn(getSpecsDir(args)[0])
+			return
+		}
 		formatter.FormatSpecFilesIn(getSpecsDir(args)[0])
 	},
 	DisableAutoGenTag: true,
 }
 
+var checkFormat bool
+
 func init() {
 	GaugeCmd.AddCommand(formatCmd)
+	formatCmd.Flags().BoolVarP(&checkFormat, "check", "", false, "List the files which are not formatted, with a unified diff of the changes, without changing any file. Exits with a non zero exit code if any file is not formatted")
 }
@@ -0,0 +1,66 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package cmd
+
+import (
+	"github.com/getgauge/gauge/api"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/lint"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintCmd = &cobra.Command{
+		Use:   "lint [flags] [args]",
+		Short: "Check specifications and concepts for problems",
+		Long: `Check specifications and concepts with the lint rules configured in the Lint section of manifest.json.
+Exits with a non zero exit code if an issue with error severity is found.`,
+		Example: `  gauge lint
+  gauge lint -m specs/
+  gauge lint unused specs/`,
+		Run: func(cmd *cobra.Command, args []string) {
+			loadEnvAndInitLogger(cmd)
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			lint.Lint(getSpecsDir(args), machineReadable)
+		},
+		DisableAutoGenTag: true,
+	}
+	unusedCmd = &cobra.Command{
+		Use:   "unused [flags] [args]",
+		Short: "List unused concepts and step implementations",
+		Long: `List the concepts which are not used by any spec, the concepts which are only used by other unused concepts,
+and the step implementations which are not used by any spec or concept. Step implementations are fetched from the runner.`,
+		Example: `  gauge lint unused
+  gauge lint unused -m specs/`,
+		Run: func(cmd *cobra.Command, args []string) {
+			loadEnvAndInitLogger(cmd)
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			lint.Unused(api.StartAPI(false, reporter.Current()), getSpecsDir(args), machineReadable)
+		},
+		DisableAutoGenTag: true,
+	}
+)
+
+func init() {
+	lintCmd.AddCommand(unusedCmd)
+	GaugeCmd.AddCommand(lintCmd)
+}
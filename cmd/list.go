@@ -18,6 +18,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	supersort "sort"
 
@@ -25,18 +26,34 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
 	"github.com/spf13/cobra"
 )
 
 var (
 	listCmd = &cobra.Command{
-		Use:     "list [flags] [args]",
-		Short:   "List specifications, scenarios or tags for a gauge project",
-		Long:    `List specifications, scenarios or tags for a gauge project`,
-		Example: `  gauge list --tags specs`,
+		Use:   "list [flags] [args]",
+		Short: "List specifications, scenarios, tags, steps or concepts for a gauge project",
+		Long:  `List specifications, scenarios, tags, steps or concepts for a gauge project`,
+		Example: `  gauge list --tags specs
+  gauge list --scenarios --filter-tags "login & !slow" specs
+  gauge list --steps -m`,
 		Run: func(cmd *cobra.Command, args []string) {
-			specs, failed := parser.ParseSpecs(getSpecsDir(args), gauge.NewConceptDictionary(), gauge.NewBuildErrors())
-			if failed {
+			if !specsFlag && !scenariosFlag && !tagsFlag && !stepsFlag && !conceptsFlag {
+				exit(fmt.Errorf("Missing flag, nothing to list"), cmd.UsageString())
+			}
+			filter.ExecuteTags = listTagsFilter
+			filter.ScenariosName = listScenariosFilter
+			dictionary, res, err := parser.ParseConcepts()
+			if err != nil {
+				logger.Fatalf(true, "Unable to list : %s", err.Error())
+			}
+			specs, failed := parser.ParseSpecs(getSpecsDir(args), dictionary, gauge.NewBuildErrors())
+			if failed || !res.Ok {
+				return
+			}
+			if machineReadable {
+				printListing(specs, dictionary)
 				return
 			}
 			if specsFlag {
@@ -51,15 +68,24 @@ var (
 				logger.Info(true, "[Tags]")
 				listTags(specs, print)
 			}
-			if !specsFlag && !scenariosFlag && !tagsFlag {
-				exit(fmt.Errorf("Missing flag, nothing to list"), cmd.UsageString())
+			if stepsFlag {
+				logger.Info(true, "[Steps]")
+				listSteps(specs, dictionary, print)
+			}
+			if conceptsFlag {
+				logger.Info(true, "[Concepts]")
+				listConcepts(specs, dictionary, print)
 			}
 		},
 		DisableAutoGenTag: true,
 	}
-	tagsFlag      bool
-	specsFlag     bool
-	scenariosFlag bool
+	tagsFlag            bool
+	specsFlag           bool
+	scenariosFlag       bool
+	stepsFlag           bool
+	conceptsFlag        bool
+	listTagsFilter      string
+	listScenariosFilter []string
 )
 
 func init() {
@@ -67,6 +93,10 @@ func init() {
 	listCmd.Flags().BoolVarP(&tagsFlag, "tags", "", false, "List the tags in projects")
 	listCmd.Flags().BoolVarP(&specsFlag, "specs", "", false, "List the specifications in projects")
 	listCmd.Flags().BoolVarP(&scenariosFlag, "scenarios", "", false, "List the scenarios in projects")
+	listCmd.Flags().BoolVarP(&stepsFlag, "steps", "", false, "List the steps used in projects with their usage counts and locations")
+	listCmd.Flags().BoolVarP(&conceptsFlag, "concepts", "", false, "List the concepts used in projects with their usage counts and locations")
+	listCmd.Flags().StringVarP(&listTagsFilter, "filter-tags", "t", "", "List only the specs and scenarios tagged with given tags, as gauge run --tags would execute")
+	listCmd.Flags().StringArrayVar(&listScenariosFilter, scenarioName, scenarioNameDefault, "List only the scenarios with given names, as gauge run --scenario would execute")
 }
 
 type handleResult func([]string)
@@ -127,3 +157,176 @@ func uniqueNonEmptyElementsOf(input []string) []string {
 	return us
 
 }
+
+type listedLocation struct {
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+type listedSpec struct {
+	Heading       string   `json:"heading"`
+	File          string   `json:"file"`
+	Line          int      `json:"line"`
+	Tags          []string `json:"tags"`
+	DataTableRows int      `json:"dataTableRows"`
+}
+
+type listedScenario struct {
+	Heading       string   `json:"heading"`
+	Spec          string   `json:"spec"`
+	File          string   `json:"file"`
+	Line          int      `json:"line"`
+	Tags          []string `json:"tags"`
+	DataTableRows int      `json:"dataTableRows"`
+}
+
+// listedStep is a step or a concept with the number of times it would be executed and the places where it is used.
+type listedStep struct {
+	Text      string            `json:"text"`
+	File      string            `json:"file,omitempty"`
+	Line      int               `json:"line,omitempty"`
+	Count     int               `json:"count"`
+	Locations []*listedLocation `json:"locations"`
+}
+
+func listSteps(s []*gauge.Specification, dictionary *gauge.ConceptDictionary, f handleResult) {
+	steps, _ := stepUsages(s, dictionary)
+	f(formatUsages(steps))
+}
+
+func listConcepts(s []*gauge.Specification, dictionary *gauge.ConceptDictionary, f handleResult) {
+	_, concepts := stepUsages(s, dictionary)
+	f(formatUsages(concepts))
+}
+
+func formatUsages(usages []*listedStep) []string {
+	var res []string
+	for _, usage := range usages {
+		res = append(res, fmt.Sprintf("%s (%d)", usage.Text, usage.Count))
+		for _, l := range usage.Locations {
+			res = append(res, fmt.Sprintf("  %s:%d", l.File, l.Line))
+		}
+	}
+	return res
+}
+
+// stepUsages collects the steps and concepts which would be executed for the given specs. Steps in a concept are
+// counted every time the concept is used.
+func stepUsages(s []*gauge.Specification, dictionary *gauge.ConceptDictionary) ([]*listedStep, []*listedStep) {
+	steps := make(map[string]*listedStep)
+	concepts := make(map[string]*listedStep)
+	var collect func([]*gauge.Step, string)
+	collect = func(stepsInFile []*gauge.Step, file string) {
+		for _, step := range stepsInFile {
+			usages := steps
+			if step.IsConcept {
+				usages = concepts
+			}
+			usage, ok := usages[step.Value]
+			if !ok {
+				usage = &listedStep{Text: step.Value}
+				usages[step.Value] = usage
+			}
+			usage.Count++
+			usage.addLocation(util.RelPathToProjectRoot(file), step.LineNo)
+			if step.IsConcept {
+				if concept := dictionary.Search(step.Value); concept != nil {
+					usage.File = util.RelPathToProjectRoot(concept.FileName)
+					usage.Line = concept.ConceptStep.LineNo
+					collect(concept.ConceptStep.ConceptSteps, concept.FileName)
+				}
+			}
+		}
+	}
+	for _, spec := range s {
+		collect(spec.Steps(), spec.FileName)
+	}
+	return sortedUsages(steps), sortedUsages(concepts)
+}
+
+func (usage *listedStep) addLocation(file string, line int) {
+	for _, l := range usage.Locations {
+		if l.File == file && l.Line == line {
+			return
+		}
+	}
+	usage.Locations = append(usage.Locations, &listedLocation{File: file, Line: line})
+}
+
+func sortedUsages(usages map[string]*listedStep) []*listedStep {
+	res := make([]*listedStep, 0, len(usages))
+	for _, usage := range usages {
+		res = append(res, usage)
+	}
+	supersort.Slice(res, func(i, j int) bool { return res[i].Text < res[j].Text })
+	return res
+}
+
+func tagsOf(tags *gauge.Tags) []string {
+	values := []string{}
+	if tags != nil {
+		values = append(values, tags.Values()...)
+	}
+	return values
+}
+
+func listedSpecs(s []*gauge.Specification) []*listedSpec {
+	specs := make([]*listedSpec, 0)
+	for _, spec := range s {
+		specs = append(specs, &listedSpec{
+			Heading:       spec.Heading.Value,
+			File:          util.RelPathToProjectRoot(spec.FileName),
+			Line:          spec.Heading.LineNo,
+			Tags:          tagsOf(spec.Tags),
+			DataTableRows: spec.DataTable.Table.GetRowCount(),
+		})
+	}
+	return specs
+}
+
+func listedScenarios(s []*gauge.Specification) []*listedScenario {
+	scenarios := make([]*listedScenario, 0)
+	for _, spec := range s {
+		for _, scenario := range spec.Scenarios {
+			scenarios = append(scenarios, &listedScenario{
+				Heading:       scenario.Heading.Value,
+				Spec:          spec.Heading.Value,
+				File:          util.RelPathToProjectRoot(spec.FileName),
+				Line:          scenario.Heading.LineNo,
+				Tags:          tagsOf(scenario.Tags),
+				DataTableRows: scenario.DataTable.Table.GetRowCount(),
+			})
+		}
+	}
+	return scenarios
+}
+
+// listing gives the requested lists, keyed by what is listed.
+func listing(s []*gauge.Specification, dictionary *gauge.ConceptDictionary) map[string]interface{} {
+	res := make(map[string]interface{})
+	if specsFlag {
+		res["specs"] = listedSpecs(s)
+	}
+	if scenariosFlag {
+		res["scenarios"] = listedScenarios(s)
+	}
+	if tagsFlag {
+		listTags(s, func(tags []string) { res["tags"] = tags })
+	}
+	steps, concepts := stepUsages(s, dictionary)
+	if stepsFlag {
+		res["steps"] = steps
+	}
+	if conceptsFlag {
+		res["concepts"] = concepts
+	}
+	return res
+}
+
+func printListing(s []*gauge.Specification, dictionary *gauge.ConceptDictionary) {
+	b, err := json.Marshal(listing(s, dictionary))
+	if err != nil {
+		logger.Fatalf(true, "Failed to convert the list to JSON. Reason: %s", err.Error())
+	}
+	fmt.Println(string(b))
+}
@@ -46,6 +46,60 @@ func TestOnlyUniqueSceanriosAreReturned(t *testing.T) {
 	})
 }
 
+func TestStepsAreListedWithUsages(t *testing.T) {
+	spec := buildTestSpecification()
+	spec.FileName = "spec1.spec"
+	spec.Scenarios[0].Steps[0].LineNo = 4
+	spec.Scenarios[1].Steps = append(spec.Scenarios[1].Steps, &gauge.Step{Value: "scenario1#step1", LineNo: 9}, &gauge.Step{Value: "login", LineNo: 10, IsConcept: true})
+	dictionary := gauge.NewConceptDictionary()
+	dictionary.ConceptsMap["login"] = &gauge.Concept{
+		FileName: "login.cpt",
+		ConceptStep: &gauge.Step{
+			Value:        "login",
+			LineNo:       1,
+			IsConcept:    true,
+			ConceptSteps: []*gauge.Step{&gauge.Step{Value: "scenario1#step1", LineNo: 2}},
+		},
+	}
+
+	steps, concepts := stepUsages([]*gauge.Specification{spec, spec}, dictionary)
+
+	wantSteps := []*listedStep{
+		&listedStep{Text: "scenario1#step1", Count: 6, Locations: []*listedLocation{{File: "spec1.spec", Line: 4}, {File: "spec1.spec", Line: 9}, {File: "login.cpt", Line: 2}}},
+		&listedStep{Text: "scenario2#step1", Count: 2, Locations: []*listedLocation{{File: "spec1.spec", Line: 0}}},
+	}
+	if !reflect.DeepEqual(steps, wantSteps) {
+		t.Errorf("wanted: `%v`,\n got: `%v` ", wantSteps, steps)
+	}
+	wantConcepts := []*listedStep{
+		&listedStep{Text: "login", File: "login.cpt", Line: 1, Count: 2, Locations: []*listedLocation{{File: "spec1.spec", Line: 10}}},
+	}
+	if !reflect.DeepEqual(concepts, wantConcepts) {
+		t.Errorf("wanted: `%v`,\n got: `%v` ", wantConcepts, concepts)
+	}
+}
+
+func TestListingHasOnlyRequestedLists(t *testing.T) {
+	specsFlag, scenariosFlag, tagsFlag, stepsFlag, conceptsFlag = false, true, true, false, false
+	defer func() { scenariosFlag, tagsFlag = false, false }()
+	spec := buildTestSpecification()
+	spec.FileName = "spec1.spec"
+	spec.Scenarios[0].Heading.LineNo = 3
+
+	got := listing([]*gauge.Specification{spec}, gauge.NewConceptDictionary())
+
+	want := map[string]interface{}{
+		"scenarios": []*listedScenario{
+			&listedScenario{Heading: "scenario1", Spec: "Spec1", File: "spec1.spec", Line: 3, Tags: []string{"foo", "bar"}},
+			&listedScenario{Heading: "scenario1", Spec: "Spec1", File: "spec1.spec", Tags: []string{"foo"}},
+		},
+		"tags": []string{"bar", "foo"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted: `%v`,\n got: `%v` ", want, got)
+	}
+}
+
 func buildTestSpecification() *gauge.Specification {
 	return &gauge.Specification{
 		Heading: &gauge.Heading{
@@ -19,6 +19,8 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/getgauge/gauge/reporter"
 
@@ -28,11 +30,20 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	dryRun                  bool
+	renameConcept           bool
+	inlineAt                string
+	removeConceptDefinition bool
+)
+
 var refactorCmd = &cobra.Command{
-	Use:     "refactor [flags] <old step> <new step> [args]",
-	Short:   "Refactor steps",
-	Long:    `Refactor steps.`,
-	Example: `  gauge refactor "old step" "new step"`,
+	Use:   "refactor [flags] <old step> <new step> [args]",
+	Short: "Refactor steps",
+	Long:  `Refactor steps.`,
+	Example: `  gauge refactor "old step" "new step"
+  gauge refactor --dry-run "old step" "new step"
+  gauge refactor --concept "old concept heading <a>" "new concept heading <a>"`,
 	Run: func(cmd *cobra.Command, args []string) {
 		loadEnvAndInitLogger(cmd)
 		if len(args) < 2 {
@@ -46,11 +57,102 @@ var refactorCmd = &cobra.Command{
 	DisableAutoGenTag: true,
 }
 
+var inlineConceptCmd = &cobra.Command{
+	Use:   "inline-concept [flags] <concept> [args]",
+	Short: "Replace the usages of a concept with its steps",
+	Long:  `Replace the usages of a concept with its steps, substituting the arguments of each usage for the parameters of the concept.`,
+	Example: `  gauge refactor inline-concept "login as <user>"
+  gauge refactor inline-concept --at specs/login.spec:12 "login as <user>"
+  gauge refactor inline-concept --remove-definition "login as <user>"`,
+	Run: func(cmd *cobra.Command, args []string) {
+		loadEnvAndInitLogger(cmd)
+		if len(args) < 1 {
+			exit(fmt.Errorf("Missing argument <concept>."), cmd.UsageString())
+		}
+		if err := config.SetProjectRoot(args[1:]); err != nil {
+			exit(err, cmd.UsageString())
+		}
+		file, line, err := parseLocation(inlineAt)
+		if err != nil {
+			exit(err, cmd.UsageString())
+		}
+		if dryRun {
+			refactor.PreviewInlineConcept(args[0], file, line, removeConceptDefinition, getSpecsDir(args[1:]), machineReadable)
+			return
+		}
+		refactor.RefactorInlineConcept(args[0], file, line, removeConceptDefinition, getSpecsDir(args[1:]))
+	},
+	DisableAutoGenTag: true,
+}
+
+var moveScenarioCmd = &cobra.Command{
+	Use:   "move-scenario [flags] <spec>:<line> <target spec>",
+	Short: "Move a scenario to another spec",
+	Long:  `Move a scenario with its tags and comments to the end of another spec, which is created if it does not exist. Contexts and teardown steps which the target spec does not have are added to the scenario.`,
+	Example: `  gauge refactor move-scenario specs/login.spec:12 specs/logout.spec
+  gauge refactor move-scenario --dry-run specs/login.spec:12 specs/logout.spec`,
+	Run: func(cmd *cobra.Command, args []string) {
+		loadEnvAndInitLogger(cmd)
+		if len(args) < 2 {
+			exit(fmt.Errorf("Move scenario command needs two arguments."), cmd.UsageString())
+		}
+		if err := config.SetProjectRoot(args); err != nil {
+			exit(err, cmd.UsageString())
+		}
+		file, line, err := parseLocation(args[0])
+		if err != nil || file == "" {
+			exit(fmt.Errorf("Invalid scenario location %s. Expected <spec>:<line>.", args[0]), cmd.UsageString())
+		}
+		if dryRun {
+			refactor.PreviewMoveScenario(file, line, args[1], machineReadable)
+			return
+		}
+		refactor.RefactorMoveScenario(file, line, args[1])
+	},
+	DisableAutoGenTag: true,
+}
+
 func init() {
+	refactorCmd.AddCommand(inlineConceptCmd)
+	refactorCmd.AddCommand(moveScenarioCmd)
 	GaugeCmd.AddCommand(refactorCmd)
+	refactorCmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "Print the changes as a unified diff, or as JSON with -m, without changing any file")
+	inlineConceptCmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "Print the changes as a unified diff, or as JSON with -m, without changing any file")
+	moveScenarioCmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "Print the changes as a unified diff, or as JSON with -m, without changing any file")
+	inlineConceptCmd.Flags().StringVarP(&inlineAt, "at", "", "", "Inline only the usage at <file>:<line>, instead of every usage")
+	inlineConceptCmd.Flags().BoolVarP(&removeConceptDefinition, "remove-definition", "", false, "Remove the concept definition if the concept has no usages left")
+	refactorCmd.Flags().BoolVarP(&renameConcept, "concept", "", false, "Rename a concept heading in its definition and all its usages. Does not need the runner")
 }
 
 func refactorInit(args []string) {
+	if renameConcept {
+		if dryRun {
+			refactor.PreviewConcept(args[0], args[1], getSpecsDir(args[2:]), machineReadable)
+			return
+		}
+		refactor.RefactorConcept(args[0], args[1], getSpecsDir(args[2:]))
+		return
+	}
 	startChan := api.StartAPI(false, reporter.Current())
+	if dryRun {
+		refactor.PreviewSteps(args[0], args[1], startChan, getSpecsDir(args[2:]), machineReadable)
+		return
+	}
 	refactor.RefactorSteps(args[0], args[1], startChan, getSpecsDir(args[2:]))
 }
+
+// parseLocation gives the file and line of a location in <file>:<line> format.
+func parseLocation(location string) (string, int, error) {
+	if location == "" {
+		return "", 0, nil
+	}
+	i := strings.LastIndex(location, ":")
+	if i == -1 {
+		return "", 0, fmt.Errorf("Invalid location %s. Expected <file>:<line>.", location)
+	}
+	line, err := strconv.Atoi(location[i+1:])
+	if err != nil || line < 1 {
+		return "", 0, fmt.Errorf("Invalid location %s. Expected <file>:<line>.", location)
+	}
+	return location[:i], line, nil
+}
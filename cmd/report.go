@@ -0,0 +1,128 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reportCmd = &cobra.Command{
+		Use:   "report [command]",
+		Short: "Work with saved execution results",
+		Long:  `Work with saved execution results.`,
+		Example: `  gauge report merge group1/last_run_result group2/last_run_result -o merged_result
+  gauge report replay --plugin html-report`,
+		Run: func(cmd *cobra.Command, args []string) {
+			exit(nil, cmd.UsageString())
+		},
+		DisableAutoGenTag: true,
+	}
+
+	mergeCmd = &cobra.Command{
+		Use:   "merge [flags] <result files>",
+		Short: "Merge execution results saved by separate executions",
+		Long:  `Merge execution results saved by separate executions, for example the groups of a distributed run.`,
+		Example: `  gauge report merge group1/last_run_result group2/last_run_result -o merged_result
+  gauge report merge group1/last_run_result group2/last_run_result -o merged_result --replay`,
+		Run: func(cmd *cobra.Command, args []string) {
+			loadEnvAndInitLogger(cmd)
+			if len(args) < 1 {
+				exit(fmt.Errorf("Missing argument <result files>."), cmd.UsageString())
+			}
+			if mergeOutput == "" {
+				exit(fmt.Errorf("Missing flag --output."), cmd.UsageString())
+			}
+			// groups of a distributed run execute concurrently, on separate machines.
+			execution.InParallel = true
+			res := mergeResults(args)
+			if err := execution.WriteResult(mergeOutput, res); err != nil {
+				logger.Fatal(true, err.Error())
+			}
+			logger.Infof(true, "Merged %d execution results into %s", len(args), mergeOutput)
+			if replayMerged {
+				replay(res)
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	mergeOutput  string
+	replayMerged bool
+
+	replayCmd = &cobra.Command{
+		Use:   "replay [flags] [result file]",
+		Short: "Send a saved execution result to reporting plugins",
+		Long:  `Send a saved execution result to reporting plugins, without executing the specs. Defaults to the result of the last run.`,
+		Example: `  gauge report replay
+  gauge report replay --plugin html-report
+  gauge report replay --plugin html-report merged_result`,
+		Run: func(cmd *cobra.Command, args []string) {
+			loadEnvAndInitLogger(cmd)
+			if err := config.SetProjectRoot([]string{}); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			file := execution.LastRunResultFile()
+			if len(args) > 0 {
+				file = args[0]
+			}
+			res, err := execution.ReadResult(file)
+			if err != nil {
+				logger.Fatal(true, err.Error())
+			}
+			replay(res)
+		},
+		DisableAutoGenTag: true,
+	}
+	replayPlugins []string
+)
+
+func init() {
+	reportCmd.AddCommand(mergeCmd)
+	reportCmd.AddCommand(replayCmd)
+	GaugeCmd.AddCommand(reportCmd)
+	mergeCmd.Flags().StringVarP(&mergeOutput, "output", "o", "", "File to save the merged execution result to")
+	mergeCmd.Flags().BoolVarP(&replayMerged, "replay", "", false, "Send the merged execution result to the plugins listed in the project manifest")
+	replayCmd.Flags().StringArrayVarP(&replayPlugins, "plugin", "", []string{}, "Send the execution result only to the given plugin, instead of the plugins listed in the project manifest")
+}
+
+func mergeResults(files []string) *gm.ProtoSuiteResult {
+	var results []*gm.ProtoSuiteResult
+	for _, f := range files {
+		res, err := execution.ReadResult(f)
+		if err != nil {
+			logger.Fatal(true, err.Error())
+		}
+		results = append(results, res)
+	}
+	return execution.MergeSuiteResults(results)
+}
+
+func replay(res *gm.ProtoSuiteResult) {
+	if err := config.SetProjectRoot([]string{}); err != nil {
+		logger.Fatal(true, err.Error())
+	}
+	if err := execution.ReplayResult(res, replayPlugins); err != nil {
+		logger.Fatal(true, err.Error())
+	}
+}
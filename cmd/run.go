@@ -49,6 +49,9 @@ const (
 	groupDefault           = -1
 	failSafeDefault        = false
 	skipCommandSaveDefault = false
+	eventLogDefault        = ""
+	summaryDefault         = false
+	summaryJSONDefault     = false
 
 	verboseName         = "verbose"
 	simpleConsoleName   = "simple-console"
@@ -66,6 +69,9 @@ const (
 	failSafeName        = "fail-safe"
 	skipCommandSaveName = "skip-save"
 	scenarioName        = "scenario"
+	eventLogName        = "event-log"
+	summaryName         = "summary"
+	summaryJSONName     = "summary-json"
 )
 
 var overrideRerunFlags = []string{verboseName, simpleConsoleName, machineReadableName, dirName, logLevelName}
@@ -112,6 +118,9 @@ var (
 	skipCommandSave     bool
 	scenarios           []string
 	scenarioNameDefault []string
+	eventLog            string
+	summary             bool
+	summaryJSON         bool
 )
 
 func init() {
@@ -135,6 +144,9 @@ func init() {
 	f.BoolVarP(&skipCommandSave, skipCommandSaveName, "", skipCommandSaveDefault, "Skip saving last command in lastRunCmd.json")
 	f.MarkHidden(skipCommandSaveName)
 	f.StringArrayVar(&scenarios, scenarioName, scenarioNameDefault, "Set scenarios for running specs with scenario name")
+	f.BoolVarP(&summary, summaryName, "", summaryDefault, "Print a summary of the time taken, the slowest items and the skipped items after the execution")
+	f.BoolVarP(&summaryJSON, summaryJSONName, "", summaryJSONDefault, "Save a summary of the execution as JSON in .gauge/summary.json")
+	f.StringVarP(&eventLog, eventLogName, "", eventLogDefault, "Write every execution event and the runner output to the given file as newline delimited JSON")
 }
 
 func executeFailed(cmd *cobra.Command) {
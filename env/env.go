@@ -65,28 +65,54 @@ var currentEnvironments = []string{"default"}
 //
 // Finally, all the env vars present in the map are actually set in the shell.
 func LoadEnv(envName string) error {
-	allEnvs := strings.Split(envName, ",")
+	err := loadEnvVars(config.ProjectRoot, envName)
+	if err != nil {
+		return err
+	}
+
+	for _, env := range strings.Split(envName, ",") {
+		if env = strings.TrimSpace(env); env != "default" {
+			currentEnvironments = append(currentEnvironments, env)
+		}
+	}
 
+	err = setEnvVars()
+	if err != nil {
+		return fmt.Errorf("Failed to load env. %s", err.Error())
+	}
+	return nil
+}
+
+// ProjectEnv gives the env vars of the given environments of the gauge project at root, along with the default values
+// of the env vars which are required by Gauge. Unlike LoadEnv, the env vars are not set in the shell, and the values
+// in the project take precedence over the ones already set.
+func ProjectEnv(root, envName string) (map[string]string, error) {
+	err := loadEnvVars(root, envName)
+	if err != nil {
+		return nil, err
+	}
+	return envVars, nil
+}
+
+func loadEnvVars(root, envName string) error {
 	envVars = make(map[string]string)
 
 	defaultEnvLoaded := false
-	for _, env := range allEnvs {
+	for _, env := range strings.Split(envName, ",") {
 		env = strings.TrimSpace(env)
 
-		err := loadEnvDir(env)
+		err := loadEnvDir(root, env)
 		if err != nil {
 			return fmt.Errorf("Failed to load env. %s", err.Error())
 		}
 
 		if env == "default" {
 			defaultEnvLoaded = true
-		} else {
-			currentEnvironments = append(currentEnvironments, env)
 		}
 	}
 
 	if !defaultEnvLoaded {
-		err := loadEnvDir("default")
+		err := loadEnvDir(root, "default")
 		if err != nil {
 			return fmt.Errorf("Failed to load env. %s", err.Error())
 		}
@@ -98,11 +124,6 @@ func LoadEnv(envName string) error {
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
-
-	err = setEnvVars()
-	if err != nil {
-		return fmt.Errorf("Failed to load env. %s", err.Error())
-	}
 	return nil
 }
 
@@ -119,8 +140,8 @@ func loadDefaultEnvVars() {
 	addEnvVar(useTestGA, "false")
 }
 
-func loadEnvDir(envName string) error {
-	envDirPath := filepath.Join(config.ProjectRoot, common.EnvDirectoryName, envName)
+func loadEnvDir(root, envName string) error {
+	envDirPath := filepath.Join(root, common.EnvDirectoryName, envName)
 	if !common.DirExists(envDirPath) {
 		if envName != "default" {
 			return fmt.Errorf("%s environment does not exist", envName)
@@ -228,3 +228,17 @@ func (s *MySuite) TestCurrentEnvironmentIsPopulated(c *C) {
 	c.Assert(e, Equals, nil)
 	c.Assert(CurrentEnvironments(), Equals, "default,foo")
 }
+
+func (s *MySuite) TestProjectEnvIsNotSetInShell(c *C) {
+	os.Clearenv()
+	os.Setenv("gauge_specs_dir", "specs")
+	config.ProjectRoot = "_testdata/proj1"
+
+	vars, e := ProjectEnv("_testdata/proj2", "default")
+
+	c.Assert(e, Equals, nil)
+	c.Assert(vars["gauge_specs_dir"], Equals, "anotherSpecDir")
+	c.Assert(vars["csv_delimiter"], Equals, ",")
+	c.Assert(os.Getenv("gauge_specs_dir"), Equals, "specs")
+	c.Assert(os.Getenv("gauge_reports_dir"), Equals, "")
+}
@@ -18,6 +18,8 @@
 package event
 
 import (
+	"time"
+
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
@@ -31,6 +33,7 @@ type ExecutionEvent struct {
 	Result        result.Result
 	Stream        int
 	ExecutionInfo gauge_messages.ExecutionInfo
+	Timestamp     time.Time
 }
 
 // NewExecutionEvent creates a new execution event.
@@ -41,6 +44,7 @@ func NewExecutionEvent(t Topic, i gauge.Item, r result.Result, stream int, execu
 		Result:        r,
 		Stream:        stream,
 		ExecutionInfo: executionInfo,
+		Timestamp:     time.Now(),
 	}
 }
 
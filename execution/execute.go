@@ -57,6 +57,7 @@ import (
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/rerun"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/execution/summary"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
@@ -68,6 +69,7 @@ import (
 
 const (
 	executionStatusFile = "executionStatus.json"
+	summaryFile         = "summary.json"
 )
 
 // NumberOfExecutionStreams shows the number of execution streams, in parallel execution.
@@ -79,6 +81,12 @@ var InParallel bool
 // MachineReadable indicates that the output is in json format
 var MachineReadable bool
 
+// PrintSummary indicates that a summary of the execution is printed after the result
+var PrintSummary bool
+
+// SaveSummary indicates that a summary of the execution is saved as JSON in .gauge folder
+var SaveSummary bool
+
 type suiteExecutor interface {
 	run() *result.SuiteResult
 }
@@ -127,6 +135,9 @@ var ExecuteSpecs = func(specDirs []string) int {
 		i.BufferUpdateDetails()
 		defer i.PrintUpdateBuffer()
 	}
+	if PrintSummary || SaveSummary {
+		summary.Start()
+	}
 	skel.SetupPlugins(MachineReadable)
 	res := validation.ValidateSpecs(specDirs, false)
 	if len(res.Errs) > 0 {
@@ -146,14 +157,43 @@ var ExecuteSpecs = func(specDirs []string) int {
 	event.InitRegistry()
 	wg := &sync.WaitGroup{}
 	reporter.ListenExecutionEvents(wg)
+	if reporter.EventLogFile != "" {
+		reporter.ListenAndLogExecutionEvents(wg)
+	}
 	rerun.ListenFailedScenarios(wg, specDirs)
 	if env.SaveExecutionResult() {
 		ListenSuiteEndAndSaveResult(wg)
 	}
+	if PrintSummary || SaveSummary {
+		summary.ListenExecutionEvents(wg, InParallel)
+	}
 	defer wg.Wait()
 	ei := newExecutionInfo(res.SpecCollection, res.Runner, nil, res.ErrMap, InParallel, 0)
 	e := newExecution(ei)
-	return printExecutionResult(e.run(), res.ParseOk)
+	suiteResult := e.run()
+	exitCode := printExecutionResult(suiteResult, res.ParseOk)
+	summarize(suiteResult)
+	return exitCode
+}
+
+func summarize(res *result.SuiteResult) {
+	if !PrintSummary && !SaveSummary {
+		return
+	}
+	s := summary.Get(res)
+	if PrintSummary {
+		s.Print()
+	}
+	if SaveSummary {
+		dotGaugeDir := filepath.Join(config.ProjectRoot, dotGauge)
+		if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
+			logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
+			return
+		}
+		if err := s.Write(filepath.Join(dotGaugeDir, summaryFile)); err != nil {
+			logger.Error(true, err.Error())
+		}
+	}
 }
 
 func newExecution(executionInfo *executionInfo) suiteExecutor {
@@ -22,7 +22,9 @@ import (
 
 	"strings"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
 	m "github.com/getgauge/gauge/gauge_messages"
 )
 
@@ -42,11 +44,16 @@ func mergeDataTableSpecResults(sResult *result.SuiteResult) *result.SuiteResult
 	suiteRes.PreHookScreenshots = append(suiteRes.PreHookScreenshots, sResult.PreHookScreenshots...)
 	suiteRes.PostHookScreenshots = append(suiteRes.PostHookScreenshots, sResult.PostHookScreenshots...)
 	combinedResults := make(map[string][]*result.SpecResult)
+	var fileNames []string
 	for _, res := range sResult.SpecResults {
 		fileName := res.ProtoSpec.GetFileName()
+		if _, ok := combinedResults[fileName]; !ok {
+			fileNames = append(fileNames, fileName)
+		}
 		combinedResults[fileName] = append(combinedResults[fileName], res)
 	}
-	for _, res := range combinedResults {
+	for _, fileName := range fileNames {
+		res := combinedResults[fileName]
 		mergedRes := res[0]
 		if len(res) > 1 {
 			mergedRes = mergeResults(res)
@@ -61,6 +68,67 @@ func mergeDataTableSpecResults(sResult *result.SuiteResult) *result.SuiteResult
 	return suiteRes
 }
 
+// MergeSuiteResults combines the suite results saved by separate executions of a project, for example the
+// groups of a distributed run (`gauge run -n 8 -g k`), into a single suite result. Results of a data table
+// driven spec spread across executions are merged per spec file, and the counts are recomputed.
+// Executions are assumed to have run concurrently, so the suite execution time is that of the longest one.
+func MergeSuiteResults(results []*m.ProtoSuiteResult) *m.ProtoSuiteResult {
+	sResult := &result.SuiteResult{}
+	var startTime time.Time
+	for i, res := range results {
+		if i == 0 {
+			sResult.Environment = res.Environment
+			sResult.Tags = res.Tags
+			sResult.ProjectName = res.ProjectName
+		}
+		if t, err := time.Parse(config.LayoutForTimeStamp, res.Timestamp); err == nil && (startTime.IsZero() || t.Before(startTime)) {
+			startTime = t
+			sResult.Timestamp = res.Timestamp
+		}
+		if sResult.PreSuite == nil {
+			sResult.PreSuite = res.PreHookFailure
+		}
+		if sResult.PostSuite == nil {
+			sResult.PostSuite = res.PostHookFailure
+		}
+		if res.Failed {
+			sResult.IsFailed = true
+		}
+		if res.ExecutionTime > sResult.ExecutionTime {
+			sResult.ExecutionTime = res.ExecutionTime
+		}
+		sResult.PreHookMessages = append(sResult.PreHookMessages, res.PreHookMessages...)
+		sResult.PostHookMessages = append(sResult.PostHookMessages, res.PostHookMessages...)
+		sResult.PreHookScreenshots = append(sResult.PreHookScreenshots, res.PreHookScreenshots...)
+		sResult.PostHookScreenshots = append(sResult.PostHookScreenshots, res.PostHookScreenshots...)
+		for _, specRes := range res.SpecResults {
+			sResult.SpecResults = append(sResult.SpecResults, toSpecResult(specRes))
+		}
+	}
+	if sResult.Timestamp == "" && len(results) > 0 {
+		sResult.Timestamp = results[0].Timestamp
+	}
+	merged := mergeDataTableSpecResults(sResult)
+	if merged.SpecsFailedCount > 0 {
+		merged.IsFailed = true
+	}
+	return gauge.ConvertToProtoSuiteResult(merged)
+}
+
+func toSpecResult(res *m.ProtoSpecResult) *result.SpecResult {
+	return &result.SpecResult{
+		ProtoSpec:            res.ProtoSpec,
+		ScenarioCount:        int(res.ScenarioCount),
+		ScenarioFailedCount:  int(res.ScenarioFailedCount),
+		IsFailed:             res.Failed,
+		FailedDataTableRows:  res.FailedDataTableRows,
+		ExecutionTime:        res.ExecutionTime,
+		Skipped:              res.Skipped,
+		ScenarioSkippedCount: int(res.ScenarioSkippedCount),
+		Errors:               res.Errors,
+	}
+}
+
 func mergeResults(results []*result.SpecResult) *result.SpecResult {
 	specResult := &result.SpecResult{ProtoSpec: &m.ProtoSpec{IsTableDriven: true}}
 	var scnResults []*m.ProtoItem
@@ -68,6 +136,7 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 	dataTableScnResults := make(map[string][]*m.ProtoTableDrivenScenario)
 	max := results[0].ExecutionTime
 	for _, res := range results {
+		rowOffset, rowCount := len(table.Rows), tableRowCount(res.ProtoSpec)
 		specResult.ExecutionTime += res.ExecutionTime
 		specResult.Errors = res.Errors
 		if res.ExecutionTime > max {
@@ -84,15 +153,15 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 			case m.ProtoItem_TableDrivenScenario:
 				scnResults = append(scnResults, item)
 				heading := item.TableDrivenScenario.Scenario.ScenarioHeading
-				item.TableDrivenScenario.TableRowIndex = int32(len(table.Rows) - 1)
+				item.TableDrivenScenario.TableRowIndex = mergedTableRowIndex(item.TableDrivenScenario.TableRowIndex, rowOffset, rowCount)
 				dataTableScnResults[heading] = append(dataTableScnResults[heading], item.TableDrivenScenario)
 			case m.ProtoItem_Table:
 				table.Headers = item.Table.Headers
 				table.Rows = append(table.Rows, item.Table.Rows...)
 			}
 		}
-		addHookFailure(table, res.GetPreHook(), specResult.AddPreHook)
-		addHookFailure(table, res.GetPostHook(), specResult.AddPostHook)
+		addHookFailure(rowOffset, rowCount, res.GetPreHook(), specResult.AddPreHook)
+		addHookFailure(rowOffset, rowCount, res.GetPostHook(), specResult.AddPostHook)
 	}
 	if InParallel {
 		specResult.ExecutionTime = max
@@ -105,13 +174,32 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 	return specResult
 }
 
-func addHookFailure(table *m.ProtoTable, f []*m.ProtoHookFailure, add func(...*m.ProtoHookFailure)) {
+func addHookFailure(rowOffset, rowCount int, f []*m.ProtoHookFailure, add func(...*m.ProtoHookFailure)) {
 	for _, h := range f {
-		h.TableRowIndex = int32(len(table.Rows) - 1)
+		h.TableRowIndex = mergedTableRowIndex(h.TableRowIndex, rowOffset, rowCount)
 	}
 	add(f...)
 }
 
+func tableRowCount(spec *m.ProtoSpec) int {
+	for _, item := range spec.GetItems() {
+		if item.ItemType == m.ProtoItem_Table {
+			return len(item.Table.GetRows())
+		}
+	}
+	return 0
+}
+
+// mergedTableRowIndex returns the index, in the merged data table, of a row of a partial spec result whose rows
+// are appended at rowOffset. A partial result of a single row refers to it by its index in the whole data table,
+// whereas a previously merged result refers to its rows by their index in its own table.
+func mergedTableRowIndex(index int32, rowOffset, rowCount int) int32 {
+	if rowCount > 1 && index >= 0 && int(index) < rowCount {
+		return int32(rowOffset) + index
+	}
+	return int32(rowOffset + rowCount - 1)
+}
+
 func getItems(table *m.ProtoTable, scnResults []*m.ProtoItem, results []*result.SpecResult) (items []*m.ProtoItem) {
 	index := 0
 	for _, item := range results[0].ProtoSpec.Items {
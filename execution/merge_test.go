@@ -302,3 +302,78 @@ func TestGetItems(t *testing.T) {
 		t.Errorf("Merge data table spec results failed.\n\tWant: %v\n\tGot: %v", want, got)
 	}
 }
+
+func TestMergeSuiteResults(t *testing.T) {
+	res1 := &gm.ProtoSuiteResult{
+		Environment: "env", ProjectName: "name", Timestamp: "Jan 2, 2018 at 3:05pm", ExecutionTime: int64(5),
+		PreHookMessages: []string{"message1"},
+		SpecResults: []*gm.ProtoSpecResult{
+			{
+				ProtoSpec: &gm.ProtoSpec{
+					SpecHeading: "heading1", FileName: "filename1",
+					Items: []*gm.ProtoItem{
+						{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED, ScenarioHeading: "scenario Heading1"}},
+					},
+				},
+				ScenarioCount: 1, ScenarioFailedCount: 1, Failed: true, ExecutionTime: int64(5),
+			},
+			{
+				ProtoSpec: &gm.ProtoSpec{
+					SpecHeading: "heading2", FileName: "filename2", IsTableDriven: true,
+					Items: []*gm.ProtoItem{
+						{ItemType: gm.ProtoItem_Table, Table: &gm.ProtoTable{Headers: &gm.ProtoTableRow{Cells: []string{"a"}}, Rows: []*gm.ProtoTableRow{{Cells: []string{"b"}}, {Cells: []string{"c"}}}}},
+						{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_PASSED, ScenarioHeading: "scenario Heading2"}, TableRowIndex: 0}},
+						{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_PASSED, ScenarioHeading: "scenario Heading2"}, TableRowIndex: 1}},
+					},
+				},
+				ScenarioCount: 1, ExecutionTime: int64(2),
+			},
+		},
+	}
+	res2 := &gm.ProtoSuiteResult{
+		Environment: "env", ProjectName: "name", Timestamp: "Jan 2, 2018 at 3:04pm", ExecutionTime: int64(3),
+		PreHookMessages: []string{"message2"},
+		SpecResults: []*gm.ProtoSpecResult{
+			{
+				ProtoSpec: &gm.ProtoSpec{
+					SpecHeading: "heading2", FileName: "filename2", IsTableDriven: true,
+					Items: []*gm.ProtoItem{
+						{ItemType: gm.ProtoItem_Table, Table: &gm.ProtoTable{Headers: &gm.ProtoTableRow{Cells: []string{"a"}}, Rows: []*gm.ProtoTableRow{{Cells: []string{"d"}}}}},
+						{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_PASSED, ScenarioHeading: "scenario Heading2"}, TableRowIndex: 2}},
+					},
+				},
+				ScenarioCount: 1, ExecutionTime: int64(3),
+			},
+		},
+	}
+	InParallel = true
+
+	got := MergeSuiteResults([]*gm.ProtoSuiteResult{res1, res2})
+
+	InParallel = false
+	if !got.Failed || got.SpecsFailedCount != 1 || got.SpecsSkippedCount != 0 {
+		t.Errorf("Merge suite results failed. Want: failed with 1 failed spec, Got: failed %v with %d failed specs", got.Failed, got.SpecsFailedCount)
+	}
+	if got.ExecutionTime != int64(5) || got.Timestamp != "Jan 2, 2018 at 3:04pm" {
+		t.Errorf("Merge suite results failed. Want: 5ms at Jan 2, 2018 at 3:04pm, Got: %dms at %s", got.ExecutionTime, got.Timestamp)
+	}
+	if !reflect.DeepEqual(got.PreHookMessages, []string{"message1", "message2"}) {
+		t.Errorf("Merge suite results failed. Want: %v, Got: %v", []string{"message1", "message2"}, got.PreHookMessages)
+	}
+	if len(got.SpecResults) != 2 || got.SpecResults[0].ProtoSpec.FileName != "filename1" {
+		t.Fatalf("Merge suite results failed. Want: results of filename1 and filename2, Got: %v", got.SpecResults)
+	}
+	spec := got.SpecResults[1]
+	if spec.ScenarioCount != 3 || spec.ExecutionTime != int64(3) {
+		t.Errorf("Merge suite results failed. Want: 3 scenarios in 3ms, Got: %d scenarios in %dms", spec.ScenarioCount, spec.ExecutionTime)
+	}
+	var rows []int32
+	for _, item := range spec.ProtoSpec.Items {
+		if item.ItemType == gm.ProtoItem_TableDrivenScenario {
+			rows = append(rows, item.TableDrivenScenario.TableRowIndex)
+		}
+	}
+	if !reflect.DeepEqual(rows, []int32{0, 1, 2}) {
+		t.Errorf("Merge suite results failed. Want table row indexes: %v, Got: %v", []int32{0, 1, 2}, rows)
+	}
+}
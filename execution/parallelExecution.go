@@ -33,6 +33,7 @@ import (
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/execution/summary"
 	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
@@ -156,7 +157,9 @@ func (e *parallelExecution) executeMultithreaded(totalStreams int, resChan chan
 		handlers = append(handlers, handler)
 	}
 	os.Setenv("GAUGE_API_PORTS", strings.Join(ports, ","))
-	r, err := runner.StartRunner(e.manifest, "0", reporter.ParallelReporter(0), make(chan bool), false)
+	start := time.Now()
+	r, err := runner.StartRunner(e.manifest, "0", reporter.RunnerOutput(0), make(chan bool), false)
+	summary.Record(summary.RunnerStartup, time.Since(start))
 	if err != nil {
 		fmt.Println(err)
 		return
@@ -195,7 +198,9 @@ func (e *parallelExecution) startStream(s *gauge.SpecCollection, resChan chan *r
 	if os.Getenv("GAUGE_CUSTOM_BUILD_PATH") == "" {
 		os.Setenv("GAUGE_CUSTOM_BUILD_PATH", path.Join(os.Getenv("GAUGE_PROJECT_ROOT"), "gauge_bin"))
 	}
-	runner, err := runner.Start(e.manifest, reporter.ParallelReporter(stream), make(chan bool), false)
+	start := time.Now()
+	runner, err := runner.Start(e.manifest, reporter.RunnerOutput(stream), make(chan bool), false)
+	summary.Record(summary.RunnerStartup, time.Since(start))
 	if err != nil {
 		logger.Errorf(true, "Failed to start runner. %s", err.Error())
 		resChan <- &result.SuiteResult{UnhandledErrors: []error{fmt.Errorf("Failed to start runner. %s", err.Error())}}
@@ -209,7 +214,9 @@ func (e *parallelExecution) startSpecsExecution(s *gauge.SpecCollection, resChan
 	if os.Getenv("GAUGE_CUSTOM_BUILD_PATH") == "" {
 		os.Setenv("GAUGE_CUSTOM_BUILD_PATH", path.Join(os.Getenv("GAUGE_PROJECT_ROOT"), "gauge_bin"))
 	}
-	runner, err := runner.Start(e.manifest, reporter.ParallelReporter(stream), make(chan bool), false)
+	start := time.Now()
+	runner, err := runner.Start(e.manifest, reporter.RunnerOutput(stream), make(chan bool), false)
+	summary.Record(summary.RunnerStartup, time.Since(start))
 	if err != nil {
 		logger.Errorf(true, "Failed to start runner. %s", err.Error())
 		logger.Debugf(true, "Skipping %d specifications", s.Size())
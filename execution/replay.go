@@ -0,0 +1,43 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin"
+)
+
+// ReplayResult starts the execution plugins listed in the project manifest and sends them the given suite result,
+// the same way they are notified at the end of an execution. If plugins are given, only those are started.
+func ReplayResult(res *gauge_messages.ProtoSuiteResult, plugins []string) error {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return err
+	}
+	if len(plugins) > 0 {
+		m.Plugins = plugins
+	}
+	ph := plugin.StartPlugins(m)
+	ph.NotifyPlugins(&gauge_messages.Message{
+		MessageType:          gauge_messages.Message_SuiteExecutionResult,
+		SuiteExecutionResult: &gauge_messages.SuiteExecutionResult{SuiteResult: res},
+	})
+	ph.GracefullyKillPlugins()
+	return nil
+}
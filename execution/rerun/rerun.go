@@ -112,6 +112,10 @@ func prepareScenarioFailedMetadata(res *result.ScenarioResult, sce *gauge.Scenar
 	if res.GetFailed() {
 		specPath := executionInfo.GetCurrentSpec().GetFileName()
 		failedScenario := util.RelPathToProjectRoot(specPath)
+		if id := sce.ID(); id != "" {
+			failedMeta.addFailedItem(specPath, gauge.ScenarioIDArg(id))
+			return
+		}
 		failedMeta.addFailedItem(specPath, fmt.Sprintf("%s:%v", failedScenario, sce.Span.Start))
 	}
 }
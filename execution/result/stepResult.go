@@ -114,3 +114,14 @@ func (s *StepResult) ProtoStepExecResult() *gauge_messages.ProtoStepExecutionRes
 func (s *StepResult) SetProtoExecResult(r *gauge_messages.ProtoExecutionResult) {
 	s.ProtoStep.StepExecutionResult.ExecutionResult = r
 }
+
+// SetStdout sets the output of the runner while executing the step and its hooks. It is kept apart from the messages
+// of the execution result, which are the ones the runner reports.
+func (s *StepResult) SetStdout(out string) {
+	s.ProtoStep.StepExecutionResult.Stdout = out
+}
+
+// GetStdout gives the output of the runner while executing the step and its hooks
+func (s *StepResult) GetStdout() string {
+	return s.ProtoStep.StepExecutionResult.GetStdout()
+}
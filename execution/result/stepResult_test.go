@@ -0,0 +1,34 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package result
+
+import (
+	"github.com/getgauge/gauge/gauge_messages"
+	gc "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestSetStdoutKeepsOutputOutOfExecutionResultMessages(c *gc.C) {
+	execRes := &gauge_messages.ProtoExecutionResult{Message: []string{"custom message"}}
+	res := NewStepResult(&gauge_messages.ProtoStep{StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{ExecutionResult: execRes}})
+
+	res.SetStdout("hello\nworld\n")
+
+	c.Assert(res.GetStdout(), gc.Equals, "hello\nworld\n")
+	c.Assert(res.ProtoStep.StepExecutionResult.GetStdout(), gc.Equals, "hello\nworld\n")
+	c.Assert(execRes.Message, gc.DeepEquals, []string{"custom message"})
+}
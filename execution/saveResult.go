@@ -18,6 +18,7 @@
 package execution
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
 	"github.com/golang/protobuf/proto"
 )
@@ -58,18 +60,43 @@ func ListenSuiteEndAndSaveResult(wg *sync.WaitGroup) {
 
 func writeResult(res *result.SuiteResult) {
 	dotGaugeDir := filepath.Join(config.ProjectRoot, dotGauge)
-	resultFile := filepath.Join(config.ProjectRoot, dotGauge, lastRunResult)
+	resultFile := LastRunResultFile()
 	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
 		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
 	}
-	r, err := proto.Marshal(gauge.ConvertToProtoSuiteResult(res))
+	if err := WriteResult(resultFile, gauge.ConvertToProtoSuiteResult(res)); err != nil {
+		logger.Error(true, err.Error())
+	} else {
+		logger.Debugf(true, "Last run result saved to %s", resultFile)
+	}
+}
+
+// LastRunResultFile returns the path of the suite result saved by the last execution.
+func LastRunResultFile() string {
+	return filepath.Join(config.ProjectRoot, dotGauge, lastRunResult)
+}
+
+// WriteResult saves the suite result as binary in the given file.
+func WriteResult(file string, res *gauge_messages.ProtoSuiteResult) error {
+	r, err := proto.Marshal(res)
 	if err != nil {
-		logger.Errorf(true, "Unable to marshal suite execution result, skipping save. %s", err.Error())
+		return fmt.Errorf("Unable to marshal suite execution result, skipping save. %s", err.Error())
 	}
-	err = ioutil.WriteFile(resultFile, r, common.NewFilePermissions)
+	if err = ioutil.WriteFile(file, r, common.NewFilePermissions); err != nil {
+		return fmt.Errorf("Failed to write to %s. Reason: %s", file, err.Error())
+	}
+	return nil
+}
+
+// ReadResult reads a suite result saved by a previous execution.
+func ReadResult(file string) (*gauge_messages.ProtoSuiteResult, error) {
+	b, err := ioutil.ReadFile(file)
 	if err != nil {
-		logger.Errorf(true, "Failed to write to %s. Reason: %s", resultFile, err.Error())
-	} else {
-		logger.Debugf(true, "Last run result saved to %s", resultFile)
+		return nil, fmt.Errorf("Failed to read %s. Reason: %s", file, err.Error())
+	}
+	res := &gauge_messages.ProtoSuiteResult{}
+	if err = proto.Unmarshal(b, res); err != nil {
+		return nil, fmt.Errorf("Invalid suite execution result in %s. Reason: %s", file, err.Error())
 	}
+	return res, nil
 }
@@ -24,6 +24,8 @@ import (
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/golang/protobuf/proto"
 )
 
 func TestIfResultFileIsCreated(t *testing.T) {
@@ -38,3 +40,21 @@ func TestIfResultFileIsCreated(t *testing.T) {
 	}
 	os.RemoveAll(filepath.Join(config.ProjectRoot, dotGauge))
 }
+
+func TestReadResultReturnsTheSavedResult(t *testing.T) {
+	file := filepath.Join(os.TempDir(), "gauge_result")
+	want := &gauge_messages.ProtoSuiteResult{ProjectName: "name", SpecsFailedCount: 1, Failed: true}
+	defer os.Remove(file)
+
+	if err := WriteResult(file, want); err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	got, err := ReadResult(file)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
@@ -22,9 +22,11 @@ import (
 
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/execution/summary"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
@@ -370,6 +372,11 @@ func getDataTableRows(tableRows string) (tableRowIndexes []int) {
 func executeHook(message *gauge_messages.Message, execTimeTracker result.ExecTimeTracker, r runner.Runner) *gauge_messages.ProtoExecutionResult {
 	executionResult := r.ExecuteAndGetStatus(message)
 	execTimeTracker.AddExecTime(executionResult.GetExecutionTime())
+	phase := summary.Hooks
+	if message.GetMessageType() == gauge_messages.Message_StepExecutionStarting || message.GetMessageType() == gauge_messages.Message_StepExecutionEnding {
+		phase = summary.StepHooks
+	}
+	summary.Record(phase, time.Duration(executionResult.GetExecutionTime())*time.Millisecond)
 	return executionResult
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/runner"
 )
 
@@ -47,6 +48,7 @@ func (e *stepExecutor) executeStep(step *gauge.Step, protoStep *gauge_messages.P
 	}
 	event.Notify(event.NewExecutionEvent(event.StepStart, step, nil, e.stream, *e.currentExecutionInfo))
 
+	reporter.StartCapture(e.stream)
 	e.notifyBeforeStepHook(stepResult)
 	if !stepResult.GetFailed() {
 		executeStepMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep, ExecuteStepRequest: stepRequest}
@@ -62,6 +64,7 @@ func (e *stepExecutor) executeStep(step *gauge.Step, protoStep *gauge_messages.P
 		stepResult.SetProtoExecResult(stepExecutionStatus)
 	}
 	e.notifyAfterStepHook(stepResult)
+	stepResult.SetStdout(reporter.StopCapture(e.stream))
 
 	event.Notify(event.NewExecutionEvent(event.StepEnd, *step, stepResult, e.stream, *e.currentExecutionInfo))
 	defer e.currentExecutionInfo.CurrentStep.Reset()
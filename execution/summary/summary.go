@@ -0,0 +1,356 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package summary builds a summary of an execution from the execution events. The summary breaks down the time
+taken by the run, lists the slowest specifications, scenarios and steps, shows how busy each stream was in
+a parallel run, and counts the skipped specifications and scenarios by reason.
+*/
+package summary
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+// Slowest is the number of slowest specifications, scenarios and steps listed in the summary.
+var Slowest = 5
+
+// Phase is a part of a run whose time is recorded in the summary.
+type Phase int
+
+const (
+	// Parse is the time taken to parse specifications and concepts
+	Parse Phase = iota
+	// Validation is the time taken to validate the steps with the runner
+	Validation
+	// RunnerStartup is the time taken to start runners
+	RunnerStartup
+	// Hooks is the time taken by the runner to execute suite, spec and scenario hooks
+	Hooks
+	// StepHooks is the time taken by the runner to execute step hooks
+	StepHooks
+)
+
+// Summary is the summary of an execution
+type Summary struct {
+	Time             *Time         `json:"time"`
+	SlowestSpecs     []*Item       `json:"slowestSpecs"`
+	SlowestScenarios []*Item       `json:"slowestScenarios"`
+	SlowestSteps     []*Item       `json:"slowestSteps"`
+	Streams          []*Stream     `json:"streams,omitempty"`
+	Skipped          []*SkipReason `json:"skipped,omitempty"`
+}
+
+// Time is the break down of the time taken by a run, in milliseconds. In a parallel run, runner startup,
+// hooks and steps are added up across streams and so may be more than the execution time.
+type Time struct {
+	Total         int64 `json:"total"`
+	Parse         int64 `json:"parse"`
+	Validation    int64 `json:"validation"`
+	RunnerStartup int64 `json:"runnerStartup"`
+	Execution     int64 `json:"execution"`
+	Hooks         int64 `json:"hooks"`
+	Steps         int64 `json:"steps"`
+}
+
+// Item is a specification, scenario or step with the time taken to execute it, in milliseconds.
+type Item struct {
+	Name     string `json:"name"`
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Time     int64  `json:"time"`
+}
+
+// Stream is the time, in milliseconds, an execution stream spent executing specifications and the time it was idle.
+type Stream struct {
+	ID    int   `json:"id"`
+	Specs int   `json:"specs"`
+	Busy  int64 `json:"busy"`
+	Idle  int64 `json:"idle"`
+}
+
+// SkipReason is the number of specifications and scenarios skipped for a reason.
+type SkipReason struct {
+	Reason    string `json:"reason"`
+	Specs     int    `json:"specs"`
+	Scenarios int    `json:"scenarios"`
+}
+
+type stream struct {
+	specs int
+	busy  time.Duration
+}
+
+type collector struct {
+	sync.Mutex
+	start      time.Time
+	phases     map[Phase]time.Duration
+	started    map[int][]time.Time
+	specs      []*Item
+	scenarios  []*Item
+	steps      []*Item
+	stepTime   time.Duration
+	streams    map[int]*stream
+	skipped    map[string]*SkipReason
+	skipOrder  []string
+	inParallel bool
+}
+
+var current = newCollector()
+
+func newCollector() *collector {
+	c := &collector{}
+	c.reset()
+	return c
+}
+
+func (c *collector) reset() {
+	c.start = time.Now()
+	c.phases = make(map[Phase]time.Duration)
+	c.started = make(map[int][]time.Time)
+	c.specs, c.scenarios, c.steps = nil, nil, nil
+	c.stepTime = 0
+	c.streams = make(map[int]*stream)
+	c.skipped = make(map[string]*SkipReason)
+	c.skipOrder = nil
+}
+
+// Start discards everything recorded so far and starts timing a new run.
+func Start() {
+	current.Lock()
+	defer current.Unlock()
+	current.reset()
+}
+
+// Record adds the given duration to the time taken by the phase.
+func Record(p Phase, d time.Duration) {
+	current.Lock()
+	defer current.Unlock()
+	current.phases[p] += d
+}
+
+// ListenExecutionEvents collects the time taken by specifications, scenarios and steps, and the skipped items, for the summary.
+func ListenExecutionEvents(wg *sync.WaitGroup, inParallel bool) {
+	ch := make(chan event.ExecutionEvent, 0)
+	event.Register(ch, event.SpecStart, event.SpecEnd, event.ScenarioStart, event.ScenarioEnd, event.StepStart, event.StepEnd, event.SuiteEnd)
+	current.Lock()
+	current.inParallel = inParallel
+	current.Unlock()
+	wg.Add(1)
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				wg.Done()
+				continue
+			}
+			current.collect(e)
+		}
+	}()
+}
+
+func (c *collector) collect(e event.ExecutionEvent) {
+	c.Lock()
+	defer c.Unlock()
+	switch e.Topic {
+	case event.SpecStart, event.ScenarioStart, event.StepStart:
+		c.started[e.Stream] = append(c.started[e.Stream], e.Timestamp)
+	case event.SpecEnd:
+		d := c.elapsed(e)
+		spec := e.Item.(*gauge.Specification)
+		res := e.Result.(*result.SpecResult)
+		c.specs = append(c.specs, &Item{Name: spec.Heading.Value, Filename: util.RelPathToProjectRoot(spec.FileName), Line: spec.Heading.LineNo, Time: milliseconds(d)})
+		s, ok := c.streams[e.Stream]
+		if !ok {
+			s = &stream{}
+			c.streams[e.Stream] = s
+		}
+		s.specs++
+		s.busy += d
+		if res.Skipped && len(res.Errors) > 0 {
+			c.skip(res.Errors[0].GetMessage()).Specs++
+		}
+	case event.ScenarioEnd:
+		d := c.elapsed(e)
+		scenario := e.Item.(*gauge.Scenario)
+		res := e.Result.(*result.ScenarioResult)
+		c.scenarios = append(c.scenarios, &Item{Name: scenario.Heading.Value, Filename: util.RelPathToProjectRoot(e.ExecutionInfo.GetCurrentSpec().GetFileName()), Line: scenario.Heading.LineNo, Time: milliseconds(d)})
+		if res.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+			reason := "unknown"
+			if errs := res.ProtoScenario.GetSkipErrors(); len(errs) > 0 {
+				reason = errs[0]
+			}
+			c.skip(reason).Scenarios++
+		}
+	case event.StepEnd:
+		d := c.elapsed(e)
+		step := e.Item.(gauge.Step)
+		c.steps = append(c.steps, &Item{Name: step.LineText, Filename: util.RelPathToProjectRoot(step.FileName), Line: step.LineNo, Time: milliseconds(d)})
+		c.stepTime += d
+	}
+}
+
+// elapsed returns the time since the start of the item that ended with the given event on the same stream.
+func (c *collector) elapsed(e event.ExecutionEvent) time.Duration {
+	started := c.started[e.Stream]
+	if len(started) == 0 {
+		return 0
+	}
+	start := started[len(started)-1]
+	c.started[e.Stream] = started[:len(started)-1]
+	return e.Timestamp.Sub(start)
+}
+
+func (c *collector) skip(err string) *SkipReason {
+	reason := skipReason(err)
+	r, ok := c.skipped[reason]
+	if !ok {
+		r = &SkipReason{Reason: reason}
+		c.skipped[reason] = r
+		c.skipOrder = append(c.skipOrder, reason)
+	}
+	return r
+}
+
+var locationAndStep = regexp.MustCompile(`^\S+:\d+ (.*) => '.*'$`)
+
+// skipReason removes the file, line and step from a skip error so that errors with the same cause are counted together.
+func skipReason(err string) string {
+	err = strings.TrimSpace(strings.TrimPrefix(err, "skipped Reason:"))
+	if m := locationAndStep.FindStringSubmatch(err); m != nil {
+		return m[1]
+	}
+	return err
+}
+
+// Get returns the summary of the run, given the result of its execution.
+func Get(res *result.SuiteResult) *Summary {
+	current.Lock()
+	defer current.Unlock()
+	c := current
+	execution := time.Duration(res.ExecutionTime) * time.Millisecond
+	stepTime := c.stepTime - c.phases[StepHooks]
+	if stepTime < 0 {
+		stepTime = 0
+	}
+	s := &Summary{
+		Time: &Time{
+			Total:         milliseconds(time.Since(c.start)),
+			Parse:         milliseconds(c.phases[Parse]),
+			Validation:    milliseconds(c.phases[Validation]),
+			RunnerStartup: milliseconds(c.phases[RunnerStartup]),
+			Execution:     milliseconds(execution),
+			Hooks:         milliseconds(c.phases[Hooks] + c.phases[StepHooks]),
+			Steps:         milliseconds(stepTime),
+		},
+		SlowestSpecs:     slowest(c.specs),
+		SlowestScenarios: slowest(c.scenarios),
+		SlowestSteps:     slowest(c.steps),
+	}
+	if c.inParallel {
+		for id, st := range c.streams {
+			idle := execution - st.busy
+			if idle < 0 {
+				idle = 0
+			}
+			s.Streams = append(s.Streams, &Stream{ID: id, Specs: st.specs, Busy: milliseconds(st.busy), Idle: milliseconds(idle)})
+		}
+		sort.Slice(s.Streams, func(i, j int) bool { return s.Streams[i].ID < s.Streams[j].ID })
+	}
+	for _, reason := range c.skipOrder {
+		s.Skipped = append(s.Skipped, c.skipped[reason])
+	}
+	return s
+}
+
+func slowest(items []*Item) []*Item {
+	sorted := make([]*Item, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time > sorted[j].Time })
+	if len(sorted) > Slowest {
+		sorted = sorted[:Slowest]
+	}
+	return sorted
+}
+
+func milliseconds(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
+
+// Print prints the summary on the console.
+func (s *Summary) Print() {
+	t := s.Time
+	logger.Info(true, "\nSummary:")
+	logger.Infof(true, "Time:\t%s total\t%s parse\t%s validation\t%s runner startup\t%s hooks\t%s steps",
+		duration(t.Total), duration(t.Parse), duration(t.Validation), duration(t.RunnerStartup), duration(t.Hooks), duration(t.Steps))
+	printItems("Slowest specifications:", s.SlowestSpecs)
+	printItems("Slowest scenarios:", s.SlowestScenarios)
+	printItems("Slowest steps:", s.SlowestSteps)
+	if len(s.Streams) > 0 {
+		logger.Info(true, "Streams:")
+		for _, st := range s.Streams {
+			logger.Infof(true, "\t%d\t%d specifications\t%s busy\t%s idle", st.ID, st.Specs, duration(st.Busy), duration(st.Idle))
+		}
+	}
+	if len(s.Skipped) > 0 {
+		logger.Info(true, "Skipped:")
+		for _, r := range s.Skipped {
+			logger.Infof(true, "\t%d specifications\t%d scenarios\t%s", r.Specs, r.Scenarios, r.Reason)
+		}
+	}
+}
+
+func printItems(heading string, items []*Item) {
+	if len(items) == 0 {
+		return
+	}
+	logger.Info(true, heading)
+	for _, i := range items {
+		logger.Infof(true, "\t%s\t%s:%d\t%s", duration(i.Time), i.Filename, i.Line, i.Name)
+	}
+}
+
+func duration(ms int64) time.Duration {
+	return time.Millisecond * time.Duration(ms)
+}
+
+// Write writes the summary to the given file as JSON.
+func (s *Summary) Write(file string) error {
+	b, err := json.MarshalIndent(s, "", "\t")
+	if err != nil {
+		return fmt.Errorf("Failed to write execution summary. Reason: %s", err.Error())
+	}
+	if err := ioutil.WriteFile(file, b, common.NewFilePermissions); err != nil {
+		return fmt.Errorf("Failed to write execution summary to %s. Reason: %s", file, err.Error())
+	}
+	return nil
+}
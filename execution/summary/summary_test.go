@@ -0,0 +1,116 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package summary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+var t0 = time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func notifyAt(c *collector, t event.Topic, i gauge.Item, r result.Result, stream int, ms int) {
+	e := event.NewExecutionEvent(t, i, r, stream, gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{FileName: "a.spec"}})
+	e.Timestamp = t0.Add(time.Duration(ms) * time.Millisecond)
+	c.collect(e)
+}
+
+func (s *MySuite) TestGetListsSlowestItemsAndStreamUsage(c *C) {
+	Start()
+	current.inParallel = true
+	defer func() { current.inParallel = false }()
+	spec := &gauge.Specification{FileName: "a.spec", Heading: &gauge.Heading{Value: "Spec", LineNo: 1}}
+	specRes := &result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}}
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "Scenario", LineNo: 3}}
+	scenarioRes := &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{}}
+	fast := gauge.Step{LineText: "fast step", LineNo: 4, FileName: "a.spec"}
+	slow := gauge.Step{LineText: "slow step", LineNo: 5, FileName: "a.spec"}
+
+	notifyAt(current, event.SpecStart, spec, specRes, 1, 0)
+	notifyAt(current, event.ScenarioStart, scenario, scenarioRes, 1, 10)
+	notifyAt(current, event.StepStart, &fast, nil, 1, 20)
+	notifyAt(current, event.StepEnd, fast, nil, 1, 30)
+	notifyAt(current, event.StepStart, &slow, nil, 1, 30)
+	notifyAt(current, event.StepEnd, slow, nil, 1, 80)
+	notifyAt(current, event.ScenarioEnd, scenario, scenarioRes, 1, 90)
+	notifyAt(current, event.SpecEnd, spec, specRes, 1, 100)
+	Record(StepHooks, 20*time.Millisecond)
+	Record(Hooks, 5*time.Millisecond)
+
+	got := Get(&result.SuiteResult{ExecutionTime: 150})
+
+	c.Assert(got.Time.Execution, Equals, int64(150))
+	c.Assert(got.Time.Steps, Equals, int64(40))
+	c.Assert(got.Time.Hooks, Equals, int64(25))
+	c.Assert(got.SlowestSpecs, DeepEquals, []*Item{{Name: "Spec", Filename: "a.spec", Line: 1, Time: 100}})
+	c.Assert(got.SlowestScenarios, DeepEquals, []*Item{{Name: "Scenario", Filename: "a.spec", Line: 3, Time: 80}})
+	c.Assert(got.SlowestSteps, DeepEquals, []*Item{
+		{Name: "slow step", Filename: "a.spec", Line: 5, Time: 50},
+		{Name: "fast step", Filename: "a.spec", Line: 4, Time: 10},
+	})
+	c.Assert(got.Streams, DeepEquals, []*Stream{{ID: 1, Specs: 1, Busy: 100, Idle: 50}})
+}
+
+func (s *MySuite) TestGetCountsSkippedScenariosByReason(c *C) {
+	Start()
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "Scenario", LineNo: 3}}
+	skipped := func(errs ...string) *result.ScenarioResult {
+		return &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_SKIPPED, SkipErrors: errs}}
+	}
+
+	for _, res := range []*result.ScenarioResult{
+		skipped("a.spec:4 Step implementation not found => 'first step'"),
+		skipped("b.spec:7 Step implementation not found => 'second step'"),
+		skipped("skipped Reason: Doesn't satisfy --table-rows flag condition"),
+	} {
+		notifyAt(current, event.ScenarioStart, scenario, res, 0, 0)
+		notifyAt(current, event.ScenarioEnd, scenario, res, 0, 0)
+	}
+
+	got := Get(&result.SuiteResult{})
+
+	c.Assert(got.Skipped, DeepEquals, []*SkipReason{
+		{Reason: "Step implementation not found", Scenarios: 2},
+		{Reason: "Doesn't satisfy --table-rows flag condition", Scenarios: 1},
+	})
+	c.Assert(got.Streams, IsNil)
+}
+
+func (s *MySuite) TestSlowestListsAtMostSlowestItems(c *C) {
+	var items []*Item
+	for i := 0; i < Slowest+2; i++ {
+		items = append(items, &Item{Time: int64(i)})
+	}
+
+	got := slowest(items)
+
+	c.Assert(len(got), Equals, Slowest)
+	c.Assert(got[0].Time, Equals, int64(Slowest+1))
+}
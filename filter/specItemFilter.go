@@ -34,6 +34,7 @@ import (
 
 type scenarioFilterBasedOnSpan struct {
 	lineNumbers []int
+	ids         []string
 }
 type ScenarioFilterBasedOnTags struct {
 	specTags      []string
@@ -45,7 +46,12 @@ type scenarioFilterBasedOnName struct {
 }
 
 func NewScenarioFilterBasedOnSpan(lineNumbers []int) *scenarioFilterBasedOnSpan {
-	return &scenarioFilterBasedOnSpan{lineNumbers}
+	return &scenarioFilterBasedOnSpan{lineNumbers: lineNumbers}
+}
+
+// NewScenarioFilterBasedOnSpanOrID filters out the scenarios which neither span one of the line numbers nor have one of the IDs.
+func NewScenarioFilterBasedOnSpanOrID(lineNumbers []int, ids []string) *scenarioFilterBasedOnSpan {
+	return &scenarioFilterBasedOnSpan{lineNumbers: lineNumbers, ids: ids}
 }
 
 func (filter *scenarioFilterBasedOnSpan) Filter(item gauge.Item) bool {
@@ -57,6 +63,11 @@ func (filter *scenarioFilterBasedOnSpan) Filter(item gauge.Item) bool {
 			return false
 		}
 	}
+	for _, id := range filter.ids {
+		if item.(*gauge.Scenario).ID() == id {
+			return false
+		}
+	}
 	return true
 }
 
@@ -786,3 +786,29 @@ func (s *MySuite) TestFilterInvalidScenarios(c *C) {
 	c.Assert(len(filteredScenarios), Equals, 1)
 	c.Assert(filteredScenarios[0], Equals, "First Scenario")
 }
+
+func (s *MySuite) TestScenarioSpanOrIDFilter(c *C) {
+	scenario1 := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "First Scenario"},
+		Span:    &gauge.Span{Start: 1, End: 3},
+	}
+	scenario2 := &gauge.Scenario{
+		Heading:  &gauge.Heading{Value: "Second Scenario"},
+		Span:     &gauge.Span{Start: 4, End: 6},
+		Comments: []*gauge.Comment{{Value: "<!-- scenario-id: second -->", LineNo: 5}},
+	}
+	scenario3 := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "Third Scenario"},
+		Span:    &gauge.Span{Start: 7, End: 10},
+	}
+	spec := &gauge.Specification{
+		Items:     []gauge.Item{scenario1, scenario2, scenario3},
+		Scenarios: []*gauge.Scenario{scenario1, scenario2, scenario3},
+	}
+
+	spec.Filter(NewScenarioFilterBasedOnSpanOrID([]int{8}, []string{"second"}))
+
+	c.Assert(len(spec.Scenarios), Equals, 2)
+	c.Assert(spec.Scenarios[0], Equals, scenario2)
+	c.Assert(spec.Scenarios[1], Equals, scenario3)
+}
@@ -28,6 +28,7 @@ import (
 type formatter struct {
 	buffer    bytes.Buffer
 	itemQueue *gauge.ItemQueue
+	style     *Style
 }
 
 func (formatter *formatter) Specification(specification *gauge.Specification) {
@@ -35,9 +36,9 @@ func (formatter *formatter) Specification(specification *gauge.Specification) {
 
 func (formatter *formatter) Heading(heading *gauge.Heading) {
 	if heading.HeadingType == gauge.SpecHeading {
-		formatter.buffer.WriteString(FormatHeading(heading.Value, "#"))
+		formatter.buffer.WriteString(formatter.style.heading(heading.Value, false))
 	} else if heading.HeadingType == gauge.ScenarioHeading {
-		formatter.buffer.WriteString(FormatHeading(heading.Value, "##"))
+		formatter.buffer.WriteString(formatter.style.heading(heading.Value, true))
 	}
 }
 
@@ -45,14 +46,14 @@ func (formatter *formatter) Tags(tags *gauge.Tags) {
 	if !strings.HasSuffix(formatter.buffer.String(), "\n\n") {
 		formatter.buffer.WriteString("\n")
 	}
-	formatter.buffer.WriteString(FormatTags(tags))
+	formatter.buffer.WriteString(formatTags(tags, formatter.style))
 	if formatter.itemQueue.Peek() != nil && (formatter.itemQueue.Peek().Kind() != gauge.CommentKind || strings.TrimSpace(formatter.itemQueue.Peek().(*gauge.Comment).Value) != "") {
 		formatter.buffer.WriteString("\n")
 	}
 }
 
 func (formatter *formatter) Table(table *gauge.Table) {
-	formatter.buffer.WriteString(strings.TrimPrefix(FormatTable(table), "\n"))
+	formatter.buffer.WriteString(strings.TrimPrefix(formatTable(table, formatter.style), "\n"))
 }
 
 func (formatter *formatter) DataTable(dataTable *gauge.DataTable) {
@@ -71,7 +72,28 @@ func (formatter *formatter) Scenario(scenario *gauge.Scenario) {
 }
 
 func (formatter *formatter) Step(step *gauge.Step) {
-	formatter.buffer.WriteString(FormatStep(step))
+	if formatter.style.BlankLinesBetweenSteps == nil || step.HasInlineTable || !formatter.isFollowedByStep() {
+		formatter.buffer.WriteString(formatStep(step, formatter.style))
+		return
+	}
+	for next := formatter.itemQueue.Peek(); next.Kind() == gauge.CommentKind; next = formatter.itemQueue.Peek() {
+		formatter.itemQueue.Next()
+	}
+	formatted := strings.TrimRight(formatStep(step, formatter.style), "\n") + "\n"
+	formatter.buffer.WriteString(formatted + strings.Repeat("\n", *formatter.style.BlankLinesBetweenSteps))
+}
+
+// isFollowedByStep tells if the next item, after any blank lines, is a step.
+func (formatter *formatter) isFollowedByStep() bool {
+	for _, item := range formatter.itemQueue.Items {
+		if item.Kind() == gauge.StepKind {
+			return true
+		}
+		if item.Kind() != gauge.CommentKind || item.(*gauge.Comment).Value != "\n" {
+			return false
+		}
+	}
+	return false
 }
 
 func (formatter *formatter) Comment(comment *gauge.Comment) {
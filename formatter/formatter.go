@@ -37,26 +37,98 @@ const (
 	tableLeftSpacing = 3
 )
 
+const diffContext = 3
+
+type formattedFile struct {
+	fileName string
+	content  string
+}
+
+// FormatSpecFiles formats the spec files with the style of the project and saves them.
 func FormatSpecFiles(specFiles ...string) []*parser.ParseResult {
-	specs, results := parser.ParseSpecFiles(specFiles, &gauge.ConceptDictionary{}, gauge.NewBuildErrors())
+	style, err := LoadStyle()
+	if err != nil {
+		return []*parser.ParseResult{styleError(err)}
+	}
+	files, results := formatSpecFiles(style, specFiles...)
+	return saveFormattedFiles(files, results)
+}
+
+// FormatConceptFiles formats the concept files with the style of the project and saves them.
+func FormatConceptFiles(conceptFiles ...string) []*parser.ParseResult {
+	style, err := LoadStyle()
+	if err != nil {
+		return []*parser.ParseResult{styleError(err)}
+	}
+	files, results := formatConceptFiles(style, conceptFiles...)
+	return saveFormattedFiles(files, results)
+}
+
+func styleError(err error) *parser.ParseResult {
+	return &parser.ParseResult{Ok: false, ParseErrors: []parser.ParseError{parser.ParseError{FileName: common.ManifestFile, Message: err.Error()}}}
+}
+
+func saveFormattedFiles(files []*formattedFile, results []*parser.ParseResult) []*parser.ParseResult {
 	resultsMap := getParseResult(results)
-	filesSkipped := make([]string, 0)
-	for _, spec := range specs {
-		result := resultsMap[spec.FileName]
+	for _, file := range files {
+		if err := common.SaveFile(file.fileName, file.content, true); err != nil {
+			resultsMap[file.fileName].Ok = false
+			resultsMap[file.fileName].ParseErrors = []parser.ParseError{parser.ParseError{Message: err.Error()}}
+		} else {
+			logger.Debugf(true, "Successfully formatted: %s", util.RelPathToProjectRoot(file.fileName))
+		}
+	}
+	logSkippedFiles(results)
+	return results
+}
+
+func logSkippedFiles(results []*parser.ParseResult) {
+	filesSkipped := 0
+	for _, result := range results {
 		if !result.Ok {
-			filesSkipped = append(filesSkipped, spec.FileName)
-			continue
+			filesSkipped++
 		}
-		if err := formatAndSave(spec); err != nil {
-			result.ParseErrors = []parser.ParseError{parser.ParseError{Message: err.Error()}}
-		} else {
-			logger.Debugf(true, "Successfully formatted spec: %s", util.RelPathToProjectRoot(spec.FileName))
+	}
+	if filesSkipped > 0 {
+		logger.Errorf(true, "Skipping %d file(s), due to following error(s):", filesSkipped)
+	}
+}
+
+func formatSpecFiles(style *Style, specFiles ...string) ([]*formattedFile, []*parser.ParseResult) {
+	specs, results := parser.ParseSpecFiles(specFiles, &gauge.ConceptDictionary{}, gauge.NewBuildErrors())
+	resultsMap := getParseResult(results)
+	var files []*formattedFile
+	for _, spec := range specs {
+		if resultsMap[spec.FileName].Ok {
+			files = append(files, &formattedFile{fileName: spec.FileName, content: FormatSpecificationWithStyle(spec, style)})
 		}
 	}
-	if len(filesSkipped) > 0 {
-		logger.Errorf(true, "Skipping %d file(s), due to following error(s):", len(filesSkipped))
+	return files, results
+}
+
+// formatConceptFiles formats each concept file on its own, so that a concept file is formatted even if the concepts of
+// the project cannot be put together.
+func formatConceptFiles(style *Style, conceptFiles ...string) ([]*formattedFile, []*parser.ParseResult) {
+	var files []*formattedFile
+	var results []*parser.ParseResult
+	for _, conceptFile := range conceptFiles {
+		concepts, result := new(parser.ConceptParser).ParseFile(conceptFile)
+		result.FileName = conceptFile
+		dictionary := gauge.NewConceptDictionary()
+		if len(result.ParseErrors) == 0 {
+			errs, err := parser.AddConcept(concepts, conceptFile, dictionary)
+			if err != nil {
+				errs = append(errs, parser.ParseError{FileName: conceptFile, Message: err.Error()})
+			}
+			result.ParseErrors = append(result.ParseErrors, errs...)
+		}
+		result.Ok = len(result.ParseErrors) == 0
+		results = append(results, result)
+		if result.Ok && len(dictionary.ConceptsMap) > 0 {
+			files = append(files, &formattedFile{fileName: conceptFile, content: formatConcepts(dictionary, style)[conceptFile]})
+		}
 	}
-	return results
+	return files, results
 }
 
 func getParseResult(results []*parser.ParseResult) map[string]*parser.ParseResult {
@@ -68,13 +140,17 @@ func getParseResult(results []*parser.ParseResult) map[string]*parser.ParseResul
 }
 
 func FormatStep(step *gauge.Step) string {
+	return formatStep(step, DefaultStyle)
+}
+
+func formatStep(step *gauge.Step, style *Style) string {
 	text := step.Value
 	paramCount := strings.Count(text, gauge.ParameterPlaceholder)
 	for i := 0; i < paramCount; i++ {
 		argument := step.Args[i]
 		formattedArg := ""
 		if argument.ArgType == gauge.TableArg {
-			formattedTable := FormatTable(&argument.Table)
+			formattedTable := formatTable(&argument.Table, style)
 			formattedArg = fmt.Sprintf("\n%s", formattedTable)
 		} else if argument.ArgType == gauge.Dynamic {
 			formattedArg = fmt.Sprintf("<%s>", parser.GetUnescapedString(argument.Name))
@@ -122,12 +198,21 @@ func FormatHeading(heading, headingChar string) string {
 }
 
 func FormatTable(table *gauge.Table) string {
+	return formatTable(table, DefaultStyle)
+}
+
+func formatTable(table *gauge.Table, style *Style) string {
 	columnToWidthMap := make(map[int]int)
 	for i, header := range table.Headers {
 		//table.get(header) returns a list of cells in that particular column
 		cells, _ := table.Get(header)
 		columnToWidthMap[i] = findLongestCellWidth(cells, len(header))
 	}
+	if style.TableAlignment == CompactTable {
+		for i := range table.Headers {
+			columnToWidthMap[i] = 0
+		}
+	}
 
 	var tableStringBuffer bytes.Buffer
 
@@ -141,9 +226,12 @@ func FormatTable(table *gauge.Table) string {
 
 	tableStringBuffer.WriteString("\n")
 	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
-	for i := range table.Headers {
+	for i, header := range table.Headers {
 		width := columnToWidthMap[i]
 		cell := getRepeatedChars("-", width)
+		if width == 0 {
+			cell = getRepeatedChars("-", len(header))
+		}
 		tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(cell, width)))
 	}
 
@@ -184,20 +272,34 @@ func FormatComment(comment *gauge.Comment) string {
 }
 
 func FormatTags(tags *gauge.Tags) string {
+	return formatTags(tags, DefaultStyle)
+}
+
+func formatTags(tags *gauge.Tags, style *Style) string {
 	if tags == nil || len(tags.RawValues) == 0 {
 		return ""
 	}
+	lines := tags.RawValues
+	if style.MaxTagsPerLine > 0 {
+		lines = nil
+		values := tags.Values()
+		for len(values) > style.MaxTagsPerLine {
+			lines = append(lines, values[:style.MaxTagsPerLine])
+			values = values[style.MaxTagsPerLine:]
+		}
+		lines = append(lines, values)
+	}
 	var b bytes.Buffer
 	b.WriteString("tags: ")
-	for i, tag := range tags.RawValues {
+	for i, tag := range lines {
 		for j, tagString := range tag {
 			b.WriteString(tagString)
-			if (i != len(tags.RawValues)-1) || (j != len(tag)-1) {
+			if (i != len(lines)-1) || (j != len(tag)-1) {
 				b.WriteString(", ")
 			}
 		}
 		b.WriteString("\n")
-		if i != len(tags.RawValues)-1 {
+		if i != len(lines)-1 {
 			b.WriteString("      ")
 		}
 	}
@@ -214,22 +316,105 @@ func formatExternalDataTable(dataTable *gauge.DataTable) string {
 	return string(b.Bytes())
 }
 
-func formatAndSave(spec *gauge.Specification) error {
-	formatted := FormatSpecification(spec)
-	if err := common.SaveFile(spec.FileName, formatted, true); err != nil {
-		return err
-	}
-	return nil
+func FormatSpecification(specification *gauge.Specification) string {
+	return FormatSpecificationWithStyle(specification, DefaultStyle)
 }
 
-func FormatSpecification(specification *gauge.Specification) string {
+// FormatSpecificationWithStyle formats the specification with the given style.
+func FormatSpecificationWithStyle(specification *gauge.Specification, style *Style) string {
 	var formattedSpec bytes.Buffer
 	queue := &gauge.ItemQueue{Items: specification.AllItems()}
-	formatter := &formatter{buffer: formattedSpec, itemQueue: queue}
+	formatter := &formatter{buffer: formattedSpec, itemQueue: queue, style: style}
 	specification.Traverse(formatter, queue)
+	return style.endOfFile(string(formatter.buffer.Bytes()))
+}
+
+// FormatScenarioWithStyle formats a single scenario, from its heading till its last item, with the given style.
+func FormatScenarioWithStyle(scenario *gauge.Scenario, style *Style) string {
+	var formattedScenario bytes.Buffer
+	queue := &gauge.ItemQueue{Items: scenario.Items}
+	formatter := &formatter{buffer: formattedScenario, itemQueue: queue, style: style}
+	(&gauge.Specification{Heading: scenario.Heading}).Traverse(formatter, queue)
 	return string(formatter.buffer.Bytes())
 }
 
+// AlignTable aligns the given table rows the way a table is formatted in a spec. Cells are kept as they are written,
+// separator rows are rewritten. It returns false if the lines do not make a table which can be aligned, like a table
+// with blank or repeated headers or rows with a different number of cells than the header.
+func AlignTable(lines []string, style *Style) (string, bool) {
+	var rows [][]string
+	for _, line := range lines {
+		cells, ok := TableCells(line)
+		if !ok {
+			return "", false
+		}
+		if len(rows) > 0 && isSeparatorRow(cells) {
+			continue
+		}
+		rows = append(rows, cells)
+	}
+	if len(rows) == 0 {
+		return "", false
+	}
+	headers := make(map[string]bool)
+	for _, header := range rows[0] {
+		if header == "" || headers[header] {
+			return "", false
+		}
+		headers[header] = true
+	}
+	table := &gauge.Table{}
+	table.AddHeaders(rows[0])
+	for _, row := range rows[1:] {
+		if len(row) != len(rows[0]) {
+			return "", false
+		}
+		table.AddRowValues(table.CreateTableCells(row))
+	}
+	return strings.TrimPrefix(formatTable(table, style), "\n"), true
+}
+
+// TableCells splits a table row on the unescaped pipes. Escaped pipes are kept as they are written.
+func TableCells(line string) ([]string, bool) {
+	row := strings.TrimSpace(line)
+	if len(row) < 2 || row[0] != '|' || row[len(row)-1] != '|' {
+		return nil, false
+	}
+	var cells []string
+	var cell bytes.Buffer
+	escaped := false
+	for _, char := range row[1:] {
+		if escaped {
+			cell.WriteRune(char)
+			escaped = false
+			continue
+		}
+		switch char {
+		case '\\':
+			cell.WriteRune(char)
+			escaped = true
+		case '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteRune(char)
+		}
+	}
+	if escaped || cell.Len() > 0 || len(cells) == 0 {
+		return nil, false
+	}
+	return cells, true
+}
+
+func isSeparatorRow(cells []string) bool {
+	for _, cell := range cells {
+		if cell == "" || strings.Trim(cell, "-") != "" {
+			return false
+		}
+	}
+	return true
+}
+
 func sortConcepts(conceptDictionary *gauge.ConceptDictionary, conceptMap map[string]string) []*gauge.Concept {
 	var concepts []*gauge.Concept
 	for _, concept := range conceptDictionary.ConceptsMap {
@@ -240,25 +425,34 @@ func sortConcepts(conceptDictionary *gauge.ConceptDictionary, conceptMap map[str
 	return concepts
 }
 
-func formatConceptSteps(conceptMap map[string]string, concept *gauge.Concept) {
-	conceptMap[concept.FileName] += strings.TrimSpace(strings.Replace(FormatStep(concept.ConceptStep), "*", "#", 1)) + "\n"
+func formatConceptSteps(conceptMap map[string]string, concept *gauge.Concept, style *Style) {
+	conceptMap[concept.FileName] += strings.TrimSpace(strings.Replace(formatStep(concept.ConceptStep, style), "*", "#", 1)) + "\n"
 	for i := 1; i < len(concept.ConceptStep.Items); i++ {
-		conceptMap[concept.FileName] += formatItem(concept.ConceptStep.Items[i])
+		conceptMap[concept.FileName] += formatItem(concept.ConceptStep.Items[i], style)
 	}
 }
 
 func FormatConcepts(conceptDictionary *gauge.ConceptDictionary) map[string]string {
+	return formatConcepts(conceptDictionary, DefaultStyle)
+}
+
+// formatConcepts formats the concepts with the table alignment and trailing newline of the style. Concept headings are
+// always `#` headings.
+func formatConcepts(conceptDictionary *gauge.ConceptDictionary, style *Style) map[string]string {
 	conceptMap := make(map[string]string)
 	for _, concept := range sortConcepts(conceptDictionary, conceptMap) {
 		for _, comment := range concept.ConceptStep.PreComments {
 			conceptMap[concept.FileName] += FormatComment(comment)
 		}
-		formatConceptSteps(conceptMap, concept)
+		formatConceptSteps(conceptMap, concept, style)
+	}
+	for file, content := range conceptMap {
+		conceptMap[file] = style.endOfFile(content)
 	}
 	return conceptMap
 }
 
-func formatItem(item gauge.Item) string {
+func formatItem(item gauge.Item, style *Style) string {
 	switch item.Kind() {
 	case gauge.CommentKind:
 		comment := item.(*gauge.Comment)
@@ -268,13 +462,13 @@ func formatItem(item gauge.Item) string {
 		return fmt.Sprintf("%s\n", comment.Value)
 	case gauge.StepKind:
 		step := item.(*gauge.Step)
-		return FormatStep(step)
+		return formatStep(step, style)
 	case gauge.DataTableKind:
 		dataTable := item.(*gauge.DataTable)
-		return FormatTable(&dataTable.Table)
+		return formatTable(&dataTable.Table, style)
 	case gauge.TagKind:
 		tags := item.(*gauge.Tags)
-		return FormatTags(tags)
+		return formatTags(tags, style)
 	}
 	return ""
 }
@@ -287,10 +481,78 @@ func getRepeatedChars(character string, repeatCount int) string {
 	return formatted
 }
 
+// FormatSpecFilesIn formats the spec and concept files in the given location and saves them.
 func FormatSpecFilesIn(filesLocation string) {
-	specFiles := util.GetSpecFiles([]string{filesLocation})
-	parseResults := FormatSpecFiles(specFiles...)
+	parseResults := FormatSpecFiles(util.GetSpecFiles([]string{filesLocation})...)
+	parseResults = append(parseResults, FormatConceptFiles(conceptFilesIn(filesLocation)...)...)
 	if parser.HandleParseResult(parseResults...) {
 		os.Exit(1)
 	}
 }
+
+// CheckSpecFilesIn lists the spec and concept files in the given location which are not formatted, with a unified diff
+// of the changes formatting would make. No file is changed. Exits with a non zero exit code if a file is not formatted
+// or cannot be parsed.
+func CheckSpecFilesIn(filesLocation string) {
+	style, err := LoadStyle()
+	if err != nil {
+		logger.Fatalf(true, "Unable to check formatting: %s", err.Error())
+	}
+	files, parseResults := formatSpecFiles(style, util.GetSpecFiles([]string{filesLocation})...)
+	conceptFiles, conceptResults := formatConceptFiles(style, conceptFilesIn(filesLocation)...)
+	files = append(files, conceptFiles...)
+	parseResults = append(parseResults, conceptResults...)
+	logSkippedFiles(parseResults)
+	failed := parser.HandleParseResult(parseResults...)
+	unformatted, err := unformattedFiles(files)
+	if err != nil {
+		logger.Fatalf(true, "Unable to check formatting: %s", err.Error())
+	}
+	for _, file := range unformatted {
+		fmt.Println(file.fileName)
+		fmt.Print(file.diff)
+	}
+	if len(unformatted) > 0 {
+		logger.Errorf(true, "%d file(s) are not formatted. Run `gauge format` to format them.", len(unformatted))
+		os.Exit(1)
+	}
+	if failed {
+		os.Exit(1)
+	}
+	logger.Infof(true, "All files are formatted.")
+}
+
+// unformattedFile is a file which is not formatted, with the unified diff of the changes formatting would make.
+type unformattedFile struct {
+	fileName string
+	diff     string
+}
+
+// unformattedFiles gives the files whose content on disk differs from the formatted content, sorted by file name. The
+// file names are relative to the project root.
+func unformattedFiles(files []*formattedFile) ([]*unformattedFile, error) {
+	sort.Slice(files, func(i, j int) bool { return files[i].fileName < files[j].fileName })
+	var unformatted []*unformattedFile
+	for _, file := range files {
+		content, err := common.ReadFileContents(file.fileName)
+		if err != nil {
+			return nil, err
+		}
+		if content != file.content {
+			name := util.RelPathToProjectRoot(file.fileName)
+			diff := util.UnifiedDiff("a/"+name, "b/"+name, content, file.content, diffContext)
+			unformatted = append(unformatted, &unformattedFile{fileName: name, diff: diff})
+		}
+	}
+	return unformatted, nil
+}
+
+func conceptFilesIn(filesLocation string) []string {
+	if util.IsConcept(filesLocation) {
+		return []string{filesLocation}
+	}
+	if common.DirExists(filesLocation) {
+		return util.FindConceptFilesIn(filesLocation)
+	}
+	return nil
+}
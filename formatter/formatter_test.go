@@ -18,6 +18,9 @@
 package formatter
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/getgauge/gauge/env"
@@ -446,3 +449,98 @@ func (s *MySuite) TestFormatShouldNotAddExtraNewLinesBeforeDataTable(c *C) {
    |Rhythm|0          |
 `)
 }
+
+func (s *MySuite) TestFormatConceptFiles(c *C) {
+	dir, err := ioutil.TempDir("", "format")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	formatted := filepath.Join(dir, "formatted.cpt")
+	c.Assert(ioutil.WriteFile(formatted, []byte("# logout\n* bye\n"), 0644), IsNil)
+	unformatted := filepath.Join(dir, "unformatted.cpt")
+	c.Assert(ioutil.WriteFile(unformatted, []byte("#   login as <user>\n*    enter <user>\n"), 0644), IsNil)
+	invalid := filepath.Join(dir, "invalid.cpt")
+	c.Assert(ioutil.WriteFile(invalid, []byte("# no steps\n"), 0644), IsNil)
+
+	files, results := formatConceptFiles(DefaultStyle, formatted, unformatted, invalid)
+
+	c.Assert(len(files), Equals, 2)
+	c.Assert(files[1].fileName, Equals, unformatted)
+	c.Assert(files[1].content, Equals, "# login as <user>\n* enter <user>\n")
+	c.Assert(results[0].Ok, Equals, true)
+	c.Assert(results[1].Ok, Equals, true)
+	c.Assert(results[2].Ok, Equals, false)
+	c.Assert(results[2].FileName, Equals, invalid)
+
+	notFormatted, err := unformattedFiles(files)
+	c.Assert(err, IsNil)
+	c.Assert(len(notFormatted), Equals, 1)
+	c.Assert(notFormatted[0].diff, Matches, "(?s).*-#   login as <user>\n-\\*    enter <user>\n\\+# login as <user>\n\\+\\* enter <user>\n.*")
+}
+
+func (s *MySuite) TestUnformattedFilesWithOnlyNewlineChanges(c *C) {
+	dir, err := ioutil.TempDir("", "gaugeFormat")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	crlf := filepath.Join(dir, "crlf.cpt")
+	c.Assert(ioutil.WriteFile(crlf, []byte("# logout\r\n* bye\r\n"), 0644), IsNil)
+	trailing := filepath.Join(dir, "trailing.cpt")
+	c.Assert(ioutil.WriteFile(trailing, []byte("# logout\n* bye\n"), 0644), IsNil)
+	style := *DefaultStyle
+	style.TrailingNewline = NoNewline
+
+	crlfFiles, _ := formatConceptFiles(DefaultStyle, crlf)
+	trailingFiles, _ := formatConceptFiles(&style, trailing)
+	unformatted, err := unformattedFiles(append(crlfFiles, trailingFiles...))
+
+	c.Assert(err, IsNil)
+	c.Assert(len(unformatted), Equals, 2)
+	c.Assert(filepath.Base(unformatted[0].fileName), Equals, "crlf.cpt")
+	c.Assert(unformatted[0].diff, Matches, "(?s).*-# logout\r\n-\\* bye\r\n\\+# logout\n\\+\\* bye\n")
+	c.Assert(filepath.Base(unformatted[1].fileName), Equals, "trailing.cpt")
+	c.Assert(unformatted[1].diff, Matches, "(?s).*-\\* bye\n\\+\\* bye\n\\\\ No newline at end of file\n")
+}
+
+func (s *MySuite) TestAlignTable(c *C) {
+	got, ok := AlignTable([]string{
+		"|id|name  |",
+		"|-|-|",
+		"  |1 | john\\|doe|",
+		"|20|<name>|",
+	}, DefaultStyle)
+
+	c.Assert(ok, Equals, true)
+	c.Assert(got, Equals, `   |id|name     |
+   |--|---------|
+   |1 |john\|doe|
+   |20|<name>   |
+`)
+}
+
+func (s *MySuite) TestAlignTableWithRaggedRows(c *C) {
+	_, ok := AlignTable([]string{"|id|name|", "|1|"}, DefaultStyle)
+	c.Assert(ok, Equals, false)
+
+	_, ok = AlignTable([]string{"|id|id|", "|1|2|"}, DefaultStyle)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *MySuite) TestFormatScenario(c *C) {
+	spec, _, _ := new(parser.SpecParser).Parse(`# Spec
+## First
+* step one
+
+## Second
+*   step two
+     |id|
+     |--|
+     |1|
+`, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(FormatScenarioWithStyle(spec.Scenarios[1], DefaultStyle), Equals, `## Second
+* step two 
+
+   |id|
+   |--|
+   |1 |
+`)
+}
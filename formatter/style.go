@@ -0,0 +1,137 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package formatter
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/manifest"
+)
+
+const (
+	HashHeading      = "hash"
+	UnderlineHeading = "underline"
+	PadTable         = "pad"
+	CompactTable     = "compact"
+	KeepNewline      = "keep"
+	SingleNewline    = "single"
+	NoNewline        = "none"
+)
+
+// Style holds the formatter options, read from the Format section of the project manifest. Options which are not set
+// keep the default style.
+//
+//	"Format": {
+//	  "headingStyle": "underline",
+//	  "tableAlignment": "compact",
+//	  "maxTagsPerLine": 3,
+//	  "trailingNewline": "single",
+//	  "blankLinesBetweenSteps": 0
+//	}
+type Style struct {
+	// HeadingStyle is hash for `#` headings or underline for headings underlined with `=` and `-`.
+	HeadingStyle string `json:"headingStyle,omitempty"`
+	// TableAlignment is pad to pad the cells of a column to the same width, or compact to not pad them.
+	TableAlignment string `json:"tableAlignment,omitempty"`
+	// MaxTagsPerLine wraps the tags after so many tags. Zero keeps the tags on the lines they are written on.
+	MaxTagsPerLine int `json:"maxTagsPerLine,omitempty"`
+	// TrailingNewline is keep to leave the end of the file as formatted, single to end the file with exactly one
+	// newline, or none to end it without a newline.
+	TrailingNewline string `json:"trailingNewline,omitempty"`
+	// BlankLinesBetweenSteps is the number of blank lines between two steps. If not set, blank lines are kept as written.
+	BlankLinesBetweenSteps *int `json:"blankLinesBetweenSteps,omitempty"`
+}
+
+// DefaultStyle is the style used when the project does not configure one.
+var DefaultStyle = &Style{HeadingStyle: HashHeading, TableAlignment: PadTable, TrailingNewline: KeepNewline}
+
+// LoadStyle reads the formatter style of the project. The default style is used if the project has no manifest.
+func LoadStyle() (*Style, error) {
+	if !common.FileExists(filepath.Join(config.ProjectRoot, common.ManifestFile)) {
+		return DefaultStyle, nil
+	}
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return nil, err
+	}
+	style := &Style{}
+	if len(m.Format) > 0 {
+		if err := json.Unmarshal(m.Format, style); err != nil {
+			return nil, fmt.Errorf("Failed to read formatter style. %s", err.Error())
+		}
+	}
+	return style.withDefaults()
+}
+
+func (s *Style) withDefaults() (*Style, error) {
+	style := *s
+	if style.HeadingStyle == "" {
+		style.HeadingStyle = DefaultStyle.HeadingStyle
+	}
+	if style.TableAlignment == "" {
+		style.TableAlignment = DefaultStyle.TableAlignment
+	}
+	if style.TrailingNewline == "" {
+		style.TrailingNewline = DefaultStyle.TrailingNewline
+	}
+	if style.HeadingStyle != HashHeading && style.HeadingStyle != UnderlineHeading {
+		return nil, fmt.Errorf("Invalid heading style %s. Expected %s or %s", style.HeadingStyle, HashHeading, UnderlineHeading)
+	}
+	if style.TableAlignment != PadTable && style.TableAlignment != CompactTable {
+		return nil, fmt.Errorf("Invalid table alignment %s. Expected %s or %s", style.TableAlignment, PadTable, CompactTable)
+	}
+	if style.TrailingNewline != KeepNewline && style.TrailingNewline != SingleNewline && style.TrailingNewline != NoNewline {
+		return nil, fmt.Errorf("Invalid trailing newline %s. Expected %s, %s or %s", style.TrailingNewline, KeepNewline, SingleNewline, NoNewline)
+	}
+	if style.MaxTagsPerLine < 0 {
+		return nil, fmt.Errorf("Invalid max tags per line %d", style.MaxTagsPerLine)
+	}
+	if style.BlankLinesBetweenSteps != nil && *style.BlankLinesBetweenSteps < 0 {
+		return nil, fmt.Errorf("Invalid blank lines between steps %d", *style.BlankLinesBetweenSteps)
+	}
+	return &style, nil
+}
+
+func (s *Style) heading(heading string, scenario bool) string {
+	if s.HeadingStyle == UnderlineHeading {
+		underline := "="
+		if scenario {
+			underline = "-"
+		}
+		trimmed := strings.TrimSpace(heading)
+		return fmt.Sprintf("%s\n%s\n", trimmed, getRepeatedChars(underline, len(trimmed)))
+	}
+	if scenario {
+		return FormatHeading(heading, "##")
+	}
+	return FormatHeading(heading, "#")
+}
+
+func (s *Style) endOfFile(content string) string {
+	switch s.TrailingNewline {
+	case SingleNewline:
+		return strings.TrimRight(content, "\n") + "\n"
+	case NoNewline:
+		return strings.TrimRight(content, "\n")
+	}
+	return content
+}
@@ -0,0 +1,143 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package formatter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func formatWithStyle(c *C, style *Style, text string) string {
+	spec, res, err := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.ParseErrors, IsNil)
+	style, err = style.withDefaults()
+	c.Assert(err, IsNil)
+	return FormatSpecificationWithStyle(spec, style)
+}
+
+func (s *MySuite) TestFormatWithUnderlineHeadingsAndCompactTables(c *C) {
+	got := formatWithStyle(c, &Style{HeadingStyle: UnderlineHeading, TableAlignment: CompactTable}, `# Spec
+
+   |name |id|
+   |-----|--|
+   |john |1 |
+
+## Scenario
+* step with <name>
+`)
+
+	c.Assert(got, Equals, `Spec
+====
+
+   |name|id|
+   |----|--|
+   |john|1|
+
+Scenario
+--------
+* step with <name>
+`)
+}
+
+func (s *MySuite) TestFormatWithMaxTagsPerLineAndTrailingNewline(c *C) {
+	got := formatWithStyle(c, &Style{MaxTagsPerLine: 2, TrailingNewline: NoNewline}, `# Spec
+tags: a, b, c
+
+## Scenario
+* step
+
+
+`)
+
+	c.Assert(got, Equals, `# Spec
+
+tags: a, b, 
+      c
+
+## Scenario
+* step`)
+}
+
+func (s *MySuite) TestFormatWithBlankLinesBetweenSteps(c *C) {
+	none, one := 0, 1
+	text := `# Spec
+
+## Scenario
+* first
+
+
+* second
+* third
+
+## Other
+* fourth
+`
+
+	c.Assert(formatWithStyle(c, &Style{BlankLinesBetweenSteps: &none}, text), Equals, `# Spec
+
+## Scenario
+* first
+* second
+* third
+
+## Other
+* fourth
+`)
+	c.Assert(formatWithStyle(c, &Style{BlankLinesBetweenSteps: &one}, text), Equals, `# Spec
+
+## Scenario
+* first
+
+* second
+
+* third
+
+## Other
+* fourth
+`)
+}
+
+func (s *MySuite) TestLoadStyle(c *C) {
+	dir, err := ioutil.TempDir("", "format")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	style, err := LoadStyle()
+	c.Assert(err, IsNil)
+	c.Assert(style, Equals, DefaultStyle)
+
+	manifest := `{"Language": "java", "Format": {"headingStyle": "underline", "maxTagsPerLine": 3}}`
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "manifest.json"), []byte(manifest), 0644), IsNil)
+	style, err = LoadStyle()
+	c.Assert(err, IsNil)
+	c.Assert(*style, DeepEquals, Style{HeadingStyle: UnderlineHeading, TableAlignment: PadTable, MaxTagsPerLine: 3, TrailingNewline: KeepNewline})
+
+	manifest = `{"Language": "java", "Format": {"tableAlignment": "right"}}`
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "manifest.json"), []byte(manifest), 0644), IsNil)
+	_, err = LoadStyle()
+	c.Assert(err, ErrorMatches, "Invalid table alignment right. Expected pad or compact")
+}
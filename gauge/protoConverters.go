@@ -274,6 +274,7 @@ func NewProtoScenario(scenario *Scenario) *gauge_messages.ProtoScenario {
 		SkipErrors:      make([]string, 0),
 		Span:            &gauge_messages.Span{Start: int64(scenario.Span.Start), End: int64(scenario.Span.End)},
 		ExecutionStatus: gauge_messages.ExecutionStatus_NOTEXECUTED,
+		ID:              scenario.ID(),
 	}
 }
 
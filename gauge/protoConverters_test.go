@@ -78,6 +78,19 @@ func (s *MySuite) TestNewProtoScenario(c *C) {
 	c.Assert(protoSce.GetExecutionStatus(), Equals, gauge_messages.ExecutionStatus_NOTEXECUTED)
 	c.Assert(protoSce.Span.Start, Equals, int64(1))
 	c.Assert(protoSce.Span.End, Equals, int64(4))
+	c.Assert(protoSce.GetID(), Equals, "")
+}
+
+func (s *MySuite) TestNewProtoScenarioWithID(c *C) {
+	sce := &Scenario{
+		Heading:  &Heading{Value: "sce heading"},
+		Span:     &Span{Start: 1, End: 4},
+		Comments: []*Comment{{Value: "<!-- scenario-id: checkout-1 -->"}},
+	}
+
+	protoSce := NewProtoScenario(sce)
+
+	c.Assert(protoSce.GetID(), Equals, "checkout-1")
 }
 
 func (s *MySuite) TestConvertToProtoSpecWithDataTable(c *C) {
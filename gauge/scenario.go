@@ -18,9 +18,14 @@
 package gauge
 
 import (
+	"regexp"
 	"strings"
 )
 
+const scenarioIDArgPrefix = "id:"
+
+var scenarioIDPattern = regexp.MustCompile(`^<!--\s*scenario-id:\s*(\S+)\s*-->$`)
+
 type Scenario struct {
 	Heading                   *Heading
 	Steps                     []*Step
@@ -77,6 +82,38 @@ func (scenario *Scenario) AddDataTable(table *Table) {
 	scenario.AddItem(&scenario.DataTable)
 }
 
+// ID gives the stable ID of the scenario, declared by a `<!-- scenario-id: <id> -->` comment in the scenario.
+// It is empty if the scenario does not declare one.
+func (scenario *Scenario) ID() string {
+	for _, comment := range scenario.Comments {
+		if id := ScenarioIDFromComment(comment.Value); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// ScenarioIDFromComment gives the scenario ID declared by the comment text, or empty if it does not declare one.
+func ScenarioIDFromComment(text string) string {
+	if m := scenarioIDPattern.FindStringSubmatch(strings.TrimSpace(text)); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// ScenarioIDArg gives the argument which selects the scenario with the given ID for execution, irrespective of the spec it is in.
+func ScenarioIDArg(id string) string {
+	return scenarioIDArgPrefix + id
+}
+
+// ScenarioIDFromArg gives the scenario ID if the argument selects a scenario by its ID.
+func ScenarioIDFromArg(arg string) (string, bool) {
+	if !strings.HasPrefix(arg, scenarioIDArgPrefix) || len(arg) == len(scenarioIDArgPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(arg, scenarioIDArgPrefix), true
+}
+
 func (scenario *Scenario) InSpan(lineNumber int) bool {
 	return scenario.Span.isInRange(lineNumber)
 }
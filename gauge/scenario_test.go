@@ -68,3 +68,29 @@ func (s *MySuite) TestDoesNotUseArgsInStep(c *C) {
 
 	c.Assert(scenario.UsesArgsInSteps("foo"), Equals, false)
 }
+
+func (s *MySuite) TestScenarioID(c *C) {
+	scenario := &Scenario{}
+	scenario.AddComment(&Comment{Value: "a comment", LineNo: 2})
+	scenario.AddComment(&Comment{Value: "<!--  scenario-id: login-1 -->", LineNo: 3})
+
+	c.Assert(scenario.ID(), Equals, "login-1")
+}
+
+func (s *MySuite) TestScenarioWithoutID(c *C) {
+	scenario := &Scenario{}
+	scenario.AddComment(&Comment{Value: "scenario-id: login-1", LineNo: 2})
+
+	c.Assert(scenario.ID(), Equals, "")
+}
+
+func (s *MySuite) TestScenarioIDFromArg(c *C) {
+	id, ok := ScenarioIDFromArg(ScenarioIDArg("login-1"))
+	c.Assert(ok, Equals, true)
+	c.Assert(id, Equals, "login-1")
+
+	_, ok = ScenarioIDFromArg("specs/login.spec:3")
+	c.Assert(ok, Equals, false)
+	_, ok = ScenarioIDFromArg("id:")
+	c.Assert(ok, Equals, false)
+}
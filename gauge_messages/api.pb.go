@@ -45,6 +45,8 @@ const (
 	APIMessage_FormatSpecsRequest               APIMessage_APIMessageType = 19
 	APIMessage_FormatSpecsResponse              APIMessage_APIMessageType = 20
 	APIMessage_UnsupportedApiMessageResponse    APIMessage_APIMessageType = 21
+	APIMessage_InlineConceptRequest             APIMessage_APIMessageType = 22
+	APIMessage_InlineConceptResponse            APIMessage_APIMessageType = 23
 )
 
 var APIMessage_APIMessageType_name = map[int32]string{
@@ -70,6 +72,8 @@ var APIMessage_APIMessageType_name = map[int32]string{
 	19: "FormatSpecsRequest",
 	20: "FormatSpecsResponse",
 	21: "UnsupportedApiMessageResponse",
+	22: "InlineConceptRequest",
+	23: "InlineConceptResponse",
 }
 
 var APIMessage_APIMessageType_value = map[string]int32{
@@ -95,6 +99,8 @@ var APIMessage_APIMessageType_value = map[string]int32{
 	"FormatSpecsRequest":               19,
 	"FormatSpecsResponse":              20,
 	"UnsupportedApiMessageResponse":    21,
+	"InlineConceptRequest":             22,
+	"InlineConceptResponse":            23,
 }
 
 func (x APIMessage_APIMessageType) String() string {
@@ -1335,9 +1341,13 @@ type APIMessage struct {
 	FormatSpecsResponse *FormatSpecsResponse `protobuf:"bytes,23,opt,name=formatSpecsResponse,proto3" json:"formatSpecsResponse,omitempty"`
 	// / [UnsupportedApiMessageResponse] (#gauge.messages.UnsupportedApiMessageResponse)
 	UnsupportedApiMessageResponse *UnsupportedApiMessageResponse `protobuf:"bytes,24,opt,name=unsupportedApiMessageResponse,proto3" json:"unsupportedApiMessageResponse,omitempty"`
-	XXX_NoUnkeyedLiteral          struct{}                       `json:"-"`
-	XXX_unrecognized              []byte                         `json:"-"`
-	XXX_sizecache                 int32                          `json:"-"`
+	// / [InlineConceptRequest] (#gauge.messages.InlineConceptRequest)
+	InlineConceptRequest *InlineConceptRequest `protobuf:"bytes,25,opt,name=inlineConceptRequest,proto3" json:"inlineConceptRequest,omitempty"`
+	// / [InlineConceptResponse] (#gauge.messages.InlineConceptResponse)
+	InlineConceptResponse *InlineConceptResponse `protobuf:"bytes,26,opt,name=inlineConceptResponse,proto3" json:"inlineConceptResponse,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}               `json:"-"`
+	XXX_unrecognized      []byte                 `json:"-"`
+	XXX_sizecache         int32                  `json:"-"`
 }
 
 func (m *APIMessage) Reset()         { *m = APIMessage{} }
@@ -1533,6 +1543,147 @@ func (m *APIMessage) GetUnsupportedApiMessageResponse() *UnsupportedApiMessageRe
 	return nil
 }
 
+func (m *APIMessage) GetInlineConceptRequest() *InlineConceptRequest {
+	if m != nil {
+		return m.InlineConceptRequest
+	}
+	return nil
+}
+
+func (m *APIMessage) GetInlineConceptResponse() *InlineConceptResponse {
+	if m != nil {
+		return m.InlineConceptResponse
+	}
+	return nil
+}
+
+// / Request to inline the usages of a concept
+type InlineConceptRequest struct {
+	// / The concept heading or a usage of the concept
+	ConceptText string `protobuf:"bytes,1,opt,name=conceptText,proto3" json:"conceptText,omitempty"`
+	// / The file of the usage to inline. Every usage of the concept is inlined if it is empty.
+	FileName string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	// / The line number of the usage to inline
+	LineNumber int32 `protobuf:"varint,3,opt,name=lineNumber,proto3" json:"lineNumber,omitempty"`
+	// / Flag indicating if the concept definition should be removed once it has no usages left
+	RemoveDefinition     bool     `protobuf:"varint,4,opt,name=removeDefinition,proto3" json:"removeDefinition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InlineConceptRequest) Reset()         { *m = InlineConceptRequest{} }
+func (m *InlineConceptRequest) String() string { return proto.CompactTextString(m) }
+func (*InlineConceptRequest) ProtoMessage()    {}
+func (*InlineConceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+}
+
+func (m *InlineConceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InlineConceptRequest.Unmarshal(m, b)
+}
+func (m *InlineConceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InlineConceptRequest.Marshal(b, m, deterministic)
+}
+func (m *InlineConceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InlineConceptRequest.Merge(m, src)
+}
+func (m *InlineConceptRequest) XXX_Size() int {
+	return xxx_messageInfo_InlineConceptRequest.Size(m)
+}
+func (m *InlineConceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InlineConceptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InlineConceptRequest proto.InternalMessageInfo
+
+func (m *InlineConceptRequest) GetConceptText() string {
+	if m != nil {
+		return m.ConceptText
+	}
+	return ""
+}
+
+func (m *InlineConceptRequest) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *InlineConceptRequest) GetLineNumber() int32 {
+	if m != nil {
+		return m.LineNumber
+	}
+	return 0
+}
+
+func (m *InlineConceptRequest) GetRemoveDefinition() bool {
+	if m != nil {
+		return m.RemoveDefinition
+	}
+	return false
+}
+
+// / Response of an inline concept request
+type InlineConceptResponse struct {
+	// / Flag indicating Success
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// / Error message if the refactoring was unsuccessful.
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// / Collection of files that were changed as part of the Refactoring.
+	FilesChanged         []string `protobuf:"bytes,3,rep,name=filesChanged,proto3" json:"filesChanged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InlineConceptResponse) Reset()         { *m = InlineConceptResponse{} }
+func (m *InlineConceptResponse) String() string { return proto.CompactTextString(m) }
+func (*InlineConceptResponse) ProtoMessage()    {}
+func (*InlineConceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+
+func (m *InlineConceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InlineConceptResponse.Unmarshal(m, b)
+}
+func (m *InlineConceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InlineConceptResponse.Marshal(b, m, deterministic)
+}
+func (m *InlineConceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InlineConceptResponse.Merge(m, src)
+}
+func (m *InlineConceptResponse) XXX_Size() int {
+	return xxx_messageInfo_InlineConceptResponse.Size(m)
+}
+func (m *InlineConceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InlineConceptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InlineConceptResponse proto.InternalMessageInfo
+
+func (m *InlineConceptResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *InlineConceptResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *InlineConceptResponse) GetFilesChanged() []string {
+	if m != nil {
+		return m.FilesChanged
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("gauge.messages.APIMessage_APIMessageType", APIMessage_APIMessageType_name, APIMessage_APIMessageType_value)
 	proto.RegisterType((*GetProjectRootRequest)(nil), "gauge.messages.GetProjectRootRequest")
@@ -1562,101 +1713,109 @@ func init() {
 	proto.RegisterType((*FormatSpecsResponse)(nil), "gauge.messages.FormatSpecsResponse")
 	proto.RegisterType((*UnsupportedApiMessageResponse)(nil), "gauge.messages.UnsupportedApiMessageResponse")
 	proto.RegisterType((*APIMessage)(nil), "gauge.messages.APIMessage")
+	proto.RegisterType((*InlineConceptRequest)(nil), "gauge.messages.InlineConceptRequest")
+	proto.RegisterType((*InlineConceptResponse)(nil), "gauge.messages.InlineConceptResponse")
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xdd, 0x6e, 0xdc, 0xb6,
-	0x12, 0x3e, 0xeb, 0xdf, 0xdd, 0x59, 0x7b, 0x2d, 0x8f, 0xed, 0x35, 0xbd, 0xb1, 0x13, 0x1f, 0xe5,
-	0x07, 0x8e, 0x0f, 0xe2, 0x13, 0x38, 0x40, 0x02, 0x04, 0xe7, 0x00, 0x75, 0xfe, 0x8c, 0x45, 0xdd,
-	0xc4, 0x65, 0xec, 0x36, 0x6d, 0x81, 0x02, 0xb4, 0x96, 0x5e, 0x2b, 0xd5, 0x4a, 0xaa, 0xc8, 0x45,
-	0xd2, 0xcb, 0x5e, 0xf5, 0x29, 0xfa, 0x02, 0x7d, 0x93, 0x3e, 0x4d, 0x5f, 0xa1, 0x20, 0x45, 0xed,
-	0xae, 0x24, 0x6a, 0x6d, 0xa0, 0x77, 0xe2, 0x70, 0xe6, 0x9b, 0xe1, 0x70, 0x86, 0xfc, 0x28, 0x68,
-	0xb0, 0xd8, 0x3f, 0x88, 0x93, 0x48, 0x46, 0xd8, 0xea, 0xb3, 0x61, 0x9f, 0x1f, 0x0c, 0xb8, 0x10,
-	0xac, 0xcf, 0x45, 0x07, 0x44, 0xcc, 0xbd, 0x74, 0xce, 0xdd, 0x84, 0x8d, 0x63, 0x2e, 0x4f, 0x93,
-	0xe8, 0x23, 0xf7, 0x24, 0x8d, 0x22, 0x49, 0xf9, 0xcf, 0x43, 0x2e, 0xa4, 0xfb, 0x1c, 0xda, 0xc5,
-	0x09, 0x11, 0x47, 0xa1, 0xe0, 0xb8, 0x0b, 0xcd, 0x78, 0x2c, 0x26, 0xb5, 0xdd, 0xda, 0x5e, 0x83,
-	0x4e, 0x8a, 0xdc, 0x6d, 0xe8, 0x1c, 0x73, 0xd9, 0x0d, 0x85, 0x64, 0x41, 0xc0, 0xa4, 0x1f, 0x85,
-	0x93, 0xc8, 0x5d, 0xb8, 0x65, 0x9d, 0x35, 0xf0, 0xfb, 0xe0, 0xf8, 0x85, 0x39, 0xe3, 0xa3, 0x24,
-	0x77, 0xd7, 0x01, 0x8f, 0xb9, 0x3c, 0x0a, 0x82, 0xf7, 0x92, 0xc7, 0x22, 0x73, 0xf0, 0x35, 0xac,
-	0xe5, 0xa4, 0x06, 0xf8, 0x39, 0xd4, 0x99, 0x91, 0x91, 0xda, 0xee, 0xec, 0x5e, 0xf3, 0xf0, 0xf6,
-	0x41, 0x3e, 0x33, 0x07, 0xa7, 0x2a, 0x27, 0x4a, 0xe3, 0x1b, 0x16, 0x0c, 0x39, 0x1d, 0xe9, 0xbb,
-	0xf7, 0x60, 0xe9, 0x7d, 0xcc, 0xbd, 0xcc, 0x05, 0xae, 0xc3, 0xbc, 0x4a, 0x62, 0x0a, 0xd4, 0xa0,
-	0xe9, 0xc0, 0xfd, 0xb3, 0x06, 0xcb, 0x46, 0xcd, 0xf8, 0x7c, 0x01, 0x8b, 0x3d, 0x2e, 0x99, 0x1f,
-	0x64, 0x2e, 0xf7, 0x8a, 0x2e, 0x73, 0xfa, 0x7a, 0xf4, 0x4a, 0x1b, 0xd0, 0xcc, 0xb0, 0x23, 0x01,
-	0xc6, 0x62, 0x7c, 0x04, 0x73, 0xca, 0x99, 0x4e, 0x49, 0xf3, 0x70, 0xcb, 0xbe, 0x82, 0x98, 0x7b,
-	0x54, 0xab, 0xe1, 0x33, 0x68, 0xc6, 0x2c, 0x11, 0xfc, 0x75, 0x92, 0x44, 0x89, 0x20, 0x33, 0x3a,
-	0x88, 0x8d, 0xa2, 0x95, 0x9e, 0xa5, 0x93, 0x9a, 0xa6, 0x30, 0x8e, 0x82, 0xe0, 0x65, 0x14, 0x7a,
-	0x3c, 0x96, 0x13, 0xd9, 0x6d, 0x17, 0x27, 0xcc, 0x62, 0x9f, 0x41, 0xdd, 0x33, 0x32, 0xb3, 0xda,
-	0x5b, 0x45, 0x47, 0xc6, 0xa6, 0x1b, 0x5e, 0x46, 0x74, 0xa4, 0xec, 0xfe, 0x56, 0x83, 0xe6, 0xc4,
-	0x0c, 0xfe, 0x0f, 0x1a, 0x22, 0xdb, 0x04, 0xb3, 0xd0, 0xeb, 0xb6, 0x6a, 0x6c, 0x80, 0x1d, 0xa8,
-	0x5f, 0xfa, 0x01, 0x8f, 0x99, 0xbc, 0x22, 0x33, 0xba, 0x70, 0x46, 0x63, 0xbc, 0x0d, 0x10, 0xf8,
-	0x21, 0x7f, 0x3b, 0x1c, 0x5c, 0xf0, 0x84, 0xcc, 0xee, 0xd6, 0xf6, 0xe6, 0xe9, 0x84, 0xc4, 0xfd,
-	0x4e, 0x97, 0xce, 0x18, 0xd6, 0x6c, 0x77, 0x07, 0xea, 0x0a, 0xff, 0x8c, 0x7f, 0xce, 0x6a, 0x71,
-	0x34, 0xc6, 0x07, 0xd0, 0xba, 0x62, 0xa2, 0x1b, 0x2a, 0x94, 0x33, 0x76, 0x11, 0x70, 0xed, 0xb4,
-	0x4e, 0x0b, 0x52, 0xf7, 0x0c, 0xd6, 0xf3, 0xd0, 0x26, 0x6b, 0xff, 0x68, 0xb1, 0xee, 0xff, 0xe1,
-	0xce, 0x31, 0x97, 0x27, 0x2c, 0xec, 0x0f, 0x59, 0x9f, 0x9f, 0x06, 0xc3, 0xbe, 0x1f, 0x9e, 0xf8,
-	0x17, 0xa7, 0x4c, 0x5e, 0x4d, 0x04, 0x1f, 0x98, 0xf9, 0x2c, 0xf8, 0x6c, 0xec, 0x3e, 0x85, 0xdd,
-	0x6a, 0x73, 0x13, 0x20, 0xc2, 0x9c, 0xce, 0x65, 0x6a, 0xab, 0xbf, 0xdd, 0xfb, 0xb0, 0x9c, 0xd6,
-	0x4c, 0xa6, 0xb4, 0x0e, 0xf3, 0x5c, 0x09, 0x8c, 0x56, 0x3a, 0x70, 0xdf, 0xc1, 0xd6, 0x29, 0x4f,
-	0x2e, 0xa3, 0x64, 0x40, 0xf9, 0x25, 0xf3, 0x64, 0x94, 0xf8, 0x61, 0x3f, 0x8b, 0x8b, 0xc0, 0x62,
-	0x14, 0xf4, 0xd4, 0xaa, 0x8c, 0x51, 0x36, 0x54, 0x33, 0x21, 0xff, 0xa4, 0x67, 0xd2, 0x0d, 0xcc,
-	0x86, 0x6e, 0x02, 0x1d, 0x1b, 0xa0, 0x09, 0x82, 0xc0, 0xa2, 0x18, 0x7a, 0x1e, 0x17, 0x42, 0x23,
-	0xd6, 0x69, 0x36, 0xc4, 0x36, 0x2c, 0xf0, 0x71, 0x07, 0x34, 0xa8, 0x19, 0xa1, 0x0b, 0x4b, 0xaa,
-	0x36, 0xc4, 0xcb, 0x2b, 0x16, 0xf6, 0x79, 0x8f, 0xcc, 0xea, 0xd9, 0x9c, 0xcc, 0xfd, 0x7d, 0x06,
-	0x36, 0x5e, 0x7f, 0x96, 0x09, 0xf3, 0xa4, 0x29, 0xd2, 0x6c, 0x05, 0x4f, 0xa1, 0x69, 0x6a, 0xf8,
-	0x2d, 0x1b, 0x64, 0x9b, 0xb7, 0x5e, 0xdc, 0x3c, 0xb5, 0x59, 0x74, 0x52, 0x11, 0xf7, 0x61, 0x5e,
-	0xe8, 0x63, 0x28, 0x6d, 0x47, 0xbb, 0x45, 0xaa, 0x82, 0x8f, 0x61, 0xcd, 0xd3, 0x81, 0x1c, 0x79,
-	0x49, 0x24, 0x84, 0x39, 0x90, 0x75, 0xe9, 0xd6, 0xa9, 0x6d, 0x0a, 0xf7, 0x60, 0xc5, 0x38, 0x7b,
-	0xe3, 0x07, 0x5c, 0x47, 0x36, 0xa7, 0xb3, 0x58, 0x14, 0xe3, 0x2b, 0x70, 0x04, 0x0f, 0xb8, 0x27,
-	0x79, 0x4f, 0x95, 0xb2, 0xea, 0x3d, 0x32, 0xaf, 0x17, 0x41, 0x8a, 0x21, 0x49, 0x33, 0x4f, 0x4b,
-	0x16, 0x6e, 0x00, 0xf5, 0x6c, 0x36, 0xeb, 0xbd, 0x51, 0x3a, 0x4c, 0xef, 0x69, 0x6f, 0x0f, 0xa0,
-	0x25, 0x24, 0x4b, 0xa4, 0x1f, 0xf6, 0x4f, 0x54, 0xc7, 0x45, 0x7a, 0x73, 0xe7, 0x69, 0x41, 0x8a,
-	0xdb, 0xd0, 0xe0, 0x61, 0xcf, 0xa8, 0xa4, 0x2d, 0x3a, 0x16, 0xb8, 0x1f, 0x60, 0x4e, 0x25, 0x46,
-	0x55, 0x65, 0x38, 0xf6, 0xa2, 0xbf, 0x55, 0x11, 0xca, 0x51, 0x07, 0x36, 0x68, 0x3a, 0x50, 0x7e,
-	0x63, 0x96, 0xb0, 0x81, 0x6e, 0x43, 0x1d, 0xd9, 0xac, 0x9e, 0x2e, 0x48, 0xdd, 0x18, 0xda, 0xc5,
-	0x6d, 0x36, 0x75, 0xb5, 0x0d, 0x0d, 0x5f, 0xbc, 0xcf, 0x55, 0xd6, 0x58, 0x30, 0x2e, 0xfd, 0x99,
-	0x89, 0xd2, 0xbf, 0x51, 0x65, 0xed, 0x03, 0xbe, 0x89, 0x92, 0x01, 0x93, 0x37, 0xb8, 0x5b, 0xba,
-	0xb0, 0x96, 0xd3, 0x35, 0xa1, 0x8d, 0x0b, 0xbb, 0x96, 0x2b, 0xec, 0x0e, 0xd4, 0x3f, 0xb1, 0x24,
-	0xf4, 0xc3, 0x7e, 0x56, 0xf2, 0xa3, 0xb1, 0x7b, 0x07, 0x76, 0xce, 0x43, 0x31, 0x8c, 0xe3, 0x28,
-	0x91, 0xbc, 0x77, 0x14, 0xfb, 0x5f, 0xa5, 0xbb, 0x9c, 0x81, 0xba, 0xbf, 0x6e, 0x00, 0x1c, 0x9d,
-	0x76, 0x8d, 0x18, 0xbf, 0x84, 0xa6, 0xa9, 0x83, 0xb3, 0x5f, 0xe2, 0x34, 0xe3, 0xad, 0xc3, 0x87,
-	0xc5, 0x0a, 0x19, 0x1b, 0x4c, 0x7c, 0x2a, 0x03, 0x3a, 0x69, 0xad, 0x72, 0x69, 0x86, 0xdd, 0x9e,
-	0xce, 0xd8, 0x2c, 0x1d, 0x0b, 0xf0, 0x1c, 0x30, 0x2e, 0x71, 0x11, 0xbd, 0x5f, 0xcd, 0xc3, 0xfb,
-	0x45, 0x8f, 0x56, 0xe2, 0x42, 0x2d, 0x00, 0xf8, 0x01, 0xd6, 0xe2, 0x32, 0x93, 0xd1, 0x6d, 0xd1,
-	0x3c, 0x7c, 0x70, 0x1d, 0x6e, 0xaa, 0x4d, 0x6d, 0x10, 0xd8, 0x83, 0x4d, 0xdf, 0xce, 0x73, 0x4c,
-	0x27, 0xed, 0x5b, 0xd0, 0x2b, 0x98, 0x11, 0xad, 0x82, 0xc2, 0x3e, 0x10, 0xbf, 0x82, 0x2f, 0x91,
-	0x05, 0xed, 0xe6, 0x3f, 0x37, 0x72, 0x63, 0x56, 0x52, 0x09, 0x86, 0x27, 0xb0, 0xc2, 0xf2, 0x6c,
-	0x8a, 0x2c, 0x6a, 0x7c, 0xd7, 0x82, 0x5f, 0xe0, 0x5d, 0xb4, 0x68, 0x8a, 0xef, 0xc0, 0x61, 0x05,
-	0x16, 0x46, 0xea, 0x1a, 0xee, 0xee, 0x54, 0x38, 0x13, 0x66, 0xc9, 0x18, 0xbf, 0x80, 0x25, 0x31,
-	0xd1, 0x2a, 0xa4, 0xa1, 0xc1, 0xb6, 0x2b, 0x38, 0x55, 0x1a, 0x55, 0xce, 0x02, 0x5f, 0xc2, 0xb2,
-	0x98, 0x6c, 0x20, 0x02, 0x1a, 0x62, 0x67, 0x2a, 0x2d, 0xa3, 0x79, 0x1b, 0xb5, 0x2e, 0x51, 0xa0,
-	0x08, 0xa4, 0x59, 0xb9, 0xae, 0x22, 0x9b, 0xa0, 0x25, 0x63, 0xa4, 0xb0, 0x2a, 0x8a, 0xc4, 0x80,
-	0x2c, 0x69, 0xc4, 0x7b, 0xd3, 0x11, 0x4d, 0x80, 0x65, 0x73, 0xfc, 0x16, 0x5a, 0x41, 0x8e, 0x08,
-	0x90, 0x65, 0x0d, 0xf8, 0x5f, 0x0b, 0xe0, 0x34, 0xfe, 0x40, 0x0b, 0x30, 0xf8, 0x3d, 0xac, 0x04,
-	0x79, 0x8a, 0x40, 0x5a, 0x1a, 0xf9, 0xf1, 0xcd, 0x91, 0x4d, 0xd8, 0x45, 0x20, 0x7c, 0x92, 0x9d,
-	0xa5, 0x2b, 0xf6, 0x6d, 0xc9, 0x91, 0x8e, 0xec, 0xa8, 0x3d, 0x07, 0x64, 0x25, 0x9e, 0x4a, 0x9c,
-	0xca, 0x43, 0xa3, 0x4c, 0x6a, 0xa9, 0x05, 0x40, 0x1d, 0x1a, 0xac, 0xcc, 0x72, 0xc9, 0x6a, 0xe5,
-	0xa1, 0x61, 0xe1, 0xc4, 0xd4, 0x06, 0x81, 0x7d, 0xd8, 0x8a, 0xab, 0x68, 0x11, 0x41, 0x8d, 0x5f,
-	0x3a, 0x5e, 0x2b, 0x79, 0x14, 0xad, 0xc6, 0xc2, 0x8f, 0xd0, 0x89, 0x2b, 0xe9, 0x12, 0x59, 0xb3,
-	0x1f, 0x50, 0xd5, 0x04, 0x8b, 0x4e, 0x41, 0xc3, 0x1f, 0x60, 0x83, 0xdb, 0x58, 0x12, 0x59, 0xb7,
-	0x6f, 0x84, 0x95, 0x52, 0x51, 0x3b, 0x06, 0xfe, 0x08, 0x6d, 0x6e, 0xbd, 0x9b, 0xc9, 0x86, 0x7d,
-	0x3b, 0xec, 0x37, 0x39, 0xad, 0x40, 0x41, 0x0a, 0x78, 0x59, 0xba, 0x89, 0x49, 0xdb, 0x7e, 0xf4,
-	0x95, 0xef, 0x6c, 0x6a, 0xb1, 0xc6, 0x73, 0x58, 0xbb, 0x2c, 0xdf, 0xd8, 0x64, 0xd3, 0x7e, 0x50,
-	0x58, 0x2e, 0x77, 0x6a, 0xb3, 0x47, 0x01, 0x3b, 0xc3, 0x69, 0xb7, 0x37, 0x21, 0xda, 0xc1, 0xa3,
-	0xa2, 0x83, 0xa9, 0x57, 0x3e, 0x9d, 0x8e, 0xe9, 0xfe, 0x35, 0x07, 0xad, 0xfc, 0xad, 0x8e, 0x5b,
-	0x15, 0x7f, 0x0e, 0x9c, 0x7f, 0x61, 0xa7, 0xea, 0xdf, 0x81, 0x53, 0xc3, 0xdb, 0xd3, 0xfe, 0x0d,
-	0x38, 0x33, 0x78, 0x67, 0xea, 0xdf, 0x01, 0x67, 0x16, 0xdb, 0xb6, 0x37, 0xbf, 0x33, 0x97, 0x97,
-	0x8f, 0xf4, 0xe7, 0xd1, 0xc9, 0x3f, 0xdd, 0x9d, 0x05, 0x5c, 0x2d, 0xbc, 0xd2, 0x9d, 0x45, 0xdc,
-	0xb4, 0xbe, 0xfb, 0x9c, 0x3a, 0x12, 0xfb, 0xab, 0xcd, 0x69, 0xe0, 0xdd, 0x6b, 0x5f, 0x5e, 0x0e,
-	0xe0, 0xbd, 0xeb, 0xdf, 0x57, 0x4e, 0x53, 0x05, 0x94, 0x3b, 0xd8, 0x9c, 0x25, 0x93, 0xdd, 0xf2,
-	0x49, 0xe5, 0x2c, 0x9b, 0xec, 0x5a, 0x0e, 0x1b, 0xa7, 0x85, 0x3b, 0x53, 0x1e, 0x5c, 0xce, 0x8a,
-	0x4a, 0x7e, 0x75, 0x77, 0x3b, 0x8e, 0xf2, 0x6a, 0x6d, 0x4b, 0x67, 0x55, 0x79, 0xb5, 0xf7, 0x94,
-	0x83, 0x2a, 0xf5, 0xe5, 0x9e, 0x70, 0xd6, 0x54, 0x56, 0x2d, 0x65, 0xed, 0xac, 0xe3, 0xbf, 0xaf,
-	0x61, 0xa0, 0xce, 0xc6, 0x8b, 0x87, 0xd0, 0xf6, 0xa2, 0xc1, 0x81, 0xbc, 0x8a, 0x86, 0xfd, 0x2b,
-	0xf9, 0x29, 0x4a, 0x7e, 0x12, 0x69, 0x45, 0xff, 0x31, 0xd3, 0x3a, 0xd6, 0x95, 0x6d, 0x6c, 0xc4,
-	0xc5, 0x82, 0xfe, 0x95, 0xf5, 0xe4, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x8e, 0x11, 0x64,
-	0xf3, 0x12, 0x00, 0x00,
+	// 1540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x6e, 0x1b, 0xb7,
+	0x12, 0x3e, 0xb2, 0x2d, 0x5b, 0x1a, 0xd9, 0x32, 0x3d, 0x96, 0x65, 0x5a, 0xb1, 0x13, 0x9f, 0xcd,
+	0x0f, 0x1c, 0x1f, 0xc4, 0x27, 0x70, 0x80, 0x04, 0x08, 0x5a, 0xa0, 0xce, 0x9f, 0x21, 0xd4, 0x4d,
+	0x5c, 0xc6, 0x6e, 0xd3, 0x16, 0x28, 0xb0, 0x96, 0x28, 0x79, 0xd3, 0xd5, 0xee, 0x76, 0xb9, 0x6a,
+	0xd2, 0x27, 0xe8, 0x53, 0x14, 0xe8, 0x45, 0xaf, 0xfa, 0x26, 0x7d, 0x98, 0xbe, 0x43, 0x41, 0x2e,
+	0x57, 0xda, 0x1f, 0xae, 0x6c, 0xa0, 0xe8, 0x95, 0xc4, 0xe1, 0xcc, 0x37, 0xc3, 0xe1, 0x70, 0xf6,
+	0x23, 0xa1, 0x6e, 0x07, 0xce, 0x41, 0x10, 0xfa, 0x91, 0x8f, 0xcd, 0xa1, 0x3d, 0x1e, 0xf2, 0x83,
+	0x11, 0x17, 0xc2, 0x1e, 0x72, 0xd1, 0x01, 0x11, 0xf0, 0x5e, 0x3c, 0x67, 0x6d, 0xc2, 0xc6, 0x31,
+	0x8f, 0x4e, 0x43, 0xff, 0x3d, 0xef, 0x45, 0xcc, 0xf7, 0x23, 0xc6, 0x7f, 0x1c, 0x73, 0x11, 0x59,
+	0x4f, 0xa1, 0x9d, 0x9f, 0x10, 0x81, 0xef, 0x09, 0x8e, 0xbb, 0xd0, 0x08, 0xa6, 0x62, 0x5a, 0xd9,
+	0xad, 0xec, 0xd5, 0x59, 0x5a, 0x64, 0x6d, 0x43, 0xe7, 0x98, 0x47, 0x5d, 0x4f, 0x44, 0xb6, 0xeb,
+	0xda, 0x91, 0xe3, 0x7b, 0x69, 0xe4, 0x2e, 0xdc, 0x30, 0xce, 0x6a, 0xf8, 0x7d, 0x20, 0x4e, 0x6e,
+	0x4e, 0xfb, 0x28, 0xc8, 0xad, 0x16, 0xe0, 0x31, 0x8f, 0x8e, 0x5c, 0xf7, 0x6d, 0xc4, 0x03, 0x91,
+	0x38, 0xf8, 0x12, 0xd6, 0x33, 0x52, 0x0d, 0xfc, 0x14, 0x6a, 0xb6, 0x96, 0xd1, 0xca, 0xee, 0xfc,
+	0x5e, 0xe3, 0xf0, 0xe6, 0x41, 0x36, 0x33, 0x07, 0xa7, 0x32, 0x27, 0x52, 0xe3, 0x2b, 0xdb, 0x1d,
+	0x73, 0x36, 0xd1, 0xb7, 0xee, 0xc0, 0xf2, 0xdb, 0x80, 0xf7, 0x12, 0x17, 0xd8, 0x82, 0xaa, 0x4c,
+	0x62, 0x0c, 0x54, 0x67, 0xf1, 0xc0, 0xfa, 0xb3, 0x02, 0x2b, 0x5a, 0x4d, 0xfb, 0x7c, 0x06, 0x4b,
+	0x7d, 0x1e, 0xd9, 0x8e, 0x9b, 0xb8, 0xdc, 0xcb, 0xbb, 0xcc, 0xe8, 0xab, 0xd1, 0x0b, 0x65, 0xc0,
+	0x12, 0xc3, 0x4e, 0x04, 0x30, 0x15, 0xe3, 0x03, 0x58, 0x90, 0xce, 0x54, 0x4a, 0x1a, 0x87, 0x5b,
+	0xe6, 0x15, 0x04, 0xbc, 0xc7, 0x94, 0x1a, 0x3e, 0x81, 0x46, 0x60, 0x87, 0x82, 0xbf, 0x0c, 0x43,
+	0x3f, 0x14, 0x74, 0x4e, 0x05, 0xb1, 0x91, 0xb7, 0x52, 0xb3, 0x2c, 0xad, 0xa9, 0x0b, 0xe3, 0xc8,
+	0x75, 0x9f, 0xfb, 0x5e, 0x8f, 0x07, 0x51, 0x2a, 0xbb, 0xed, 0xfc, 0x84, 0x5e, 0xec, 0x13, 0xa8,
+	0xf5, 0xb4, 0x4c, 0xaf, 0xf6, 0x46, 0xde, 0x91, 0xb6, 0xe9, 0x7a, 0x03, 0x9f, 0x4d, 0x94, 0xad,
+	0x5f, 0x2a, 0xd0, 0x48, 0xcd, 0xe0, 0x27, 0x50, 0x17, 0xc9, 0x26, 0xe8, 0x85, 0x5e, 0xb5, 0x55,
+	0x53, 0x03, 0xec, 0x40, 0x6d, 0xe0, 0xb8, 0x3c, 0xb0, 0xa3, 0x4b, 0x3a, 0xa7, 0x0a, 0x67, 0x32,
+	0xc6, 0x9b, 0x00, 0xae, 0xe3, 0xf1, 0xd7, 0xe3, 0xd1, 0x05, 0x0f, 0xe9, 0xfc, 0x6e, 0x65, 0xaf,
+	0xca, 0x52, 0x12, 0xeb, 0x1b, 0x55, 0x3a, 0x53, 0x58, 0xbd, 0xdd, 0x1d, 0xa8, 0x49, 0xfc, 0x33,
+	0xfe, 0x31, 0xa9, 0xc5, 0xc9, 0x18, 0xef, 0x41, 0xf3, 0xd2, 0x16, 0x5d, 0x4f, 0xa2, 0x9c, 0xd9,
+	0x17, 0x2e, 0x57, 0x4e, 0x6b, 0x2c, 0x27, 0xb5, 0xce, 0xa0, 0x95, 0x85, 0xd6, 0x59, 0xfb, 0x47,
+	0x8b, 0xb5, 0x3e, 0x85, 0x5b, 0xc7, 0x3c, 0x3a, 0xb1, 0xbd, 0xe1, 0xd8, 0x1e, 0xf2, 0x53, 0x77,
+	0x3c, 0x74, 0xbc, 0x13, 0xe7, 0xe2, 0xd4, 0x8e, 0x2e, 0x53, 0xc1, 0xbb, 0x7a, 0x3e, 0x09, 0x3e,
+	0x19, 0x5b, 0x8f, 0x61, 0xb7, 0xdc, 0x5c, 0x07, 0x88, 0xb0, 0xa0, 0x72, 0x19, 0xdb, 0xaa, 0xff,
+	0xd6, 0x5d, 0x58, 0x89, 0x6b, 0x26, 0x51, 0x6a, 0x41, 0x95, 0x4b, 0x81, 0xd6, 0x8a, 0x07, 0xd6,
+	0x1b, 0xd8, 0x3a, 0xe5, 0xe1, 0xc0, 0x0f, 0x47, 0x8c, 0x0f, 0xec, 0x5e, 0xe4, 0x87, 0x8e, 0x37,
+	0x4c, 0xe2, 0xa2, 0xb0, 0xe4, 0xbb, 0x7d, 0xb9, 0x2a, 0x6d, 0x94, 0x0c, 0xe5, 0x8c, 0xc7, 0x3f,
+	0xa8, 0x99, 0x78, 0x03, 0x93, 0xa1, 0x15, 0x42, 0xc7, 0x04, 0xa8, 0x83, 0xa0, 0xb0, 0x24, 0xc6,
+	0xbd, 0x1e, 0x17, 0x42, 0x21, 0xd6, 0x58, 0x32, 0xc4, 0x36, 0x2c, 0xf2, 0xe9, 0x09, 0xa8, 0x33,
+	0x3d, 0x42, 0x0b, 0x96, 0x65, 0x6d, 0x88, 0xe7, 0x97, 0xb6, 0x37, 0xe4, 0x7d, 0x3a, 0xaf, 0x66,
+	0x33, 0x32, 0xeb, 0xd7, 0x39, 0xd8, 0x78, 0xf9, 0x31, 0x0a, 0xed, 0x5e, 0xa4, 0x8b, 0x34, 0x59,
+	0xc1, 0x63, 0x68, 0xe8, 0x1a, 0x7e, 0x6d, 0x8f, 0x92, 0xcd, 0x6b, 0xe5, 0x37, 0x4f, 0x6e, 0x16,
+	0x4b, 0x2b, 0xe2, 0x3e, 0x54, 0x85, 0x6a, 0x43, 0xf1, 0x71, 0x34, 0x5b, 0xc4, 0x2a, 0xf8, 0x10,
+	0xd6, 0x7b, 0x2a, 0x90, 0xa3, 0x5e, 0xe8, 0x0b, 0xa1, 0x1b, 0xb2, 0x2a, 0xdd, 0x1a, 0x33, 0x4d,
+	0xe1, 0x1e, 0xac, 0x6a, 0x67, 0xaf, 0x1c, 0x97, 0xab, 0xc8, 0x16, 0x54, 0x16, 0xf3, 0x62, 0x7c,
+	0x01, 0x44, 0x70, 0x97, 0xf7, 0x22, 0xde, 0x97, 0xa5, 0x2c, 0xcf, 0x1e, 0xad, 0xaa, 0x45, 0xd0,
+	0x7c, 0x48, 0x91, 0x9e, 0x67, 0x05, 0x0b, 0xcb, 0x85, 0x5a, 0x32, 0x9b, 0x9c, 0xbd, 0x49, 0x3a,
+	0xea, 0x6c, 0x32, 0x96, 0x07, 0x45, 0x44, 0x76, 0x18, 0x39, 0xde, 0xf0, 0x44, 0x9e, 0x38, 0x5f,
+	0x6d, 0x6e, 0x95, 0xe5, 0xa4, 0xb8, 0x0d, 0x75, 0xee, 0xf5, 0xb5, 0x4a, 0x7c, 0x44, 0xa7, 0x02,
+	0xeb, 0x1d, 0x2c, 0xc8, 0xc4, 0xc8, 0xaa, 0xf4, 0xa6, 0x5e, 0xd4, 0x7f, 0x59, 0x84, 0xd1, 0xe4,
+	0x04, 0xd6, 0x59, 0x3c, 0x90, 0x7e, 0x03, 0x3b, 0xb4, 0x47, 0xea, 0x18, 0xaa, 0xc8, 0xe6, 0xd5,
+	0x74, 0x4e, 0x6a, 0x05, 0xd0, 0xce, 0x6f, 0xb3, 0xae, 0xab, 0x6d, 0xa8, 0x3b, 0xe2, 0x6d, 0xa6,
+	0xb2, 0xa6, 0x82, 0x69, 0xe9, 0xcf, 0xa5, 0x4a, 0xff, 0x5a, 0x95, 0xb5, 0x0f, 0xf8, 0xca, 0x0f,
+	0x47, 0x76, 0x74, 0x8d, 0x6f, 0x4b, 0x17, 0xd6, 0x33, 0xba, 0x3a, 0xb4, 0x69, 0x61, 0x57, 0x32,
+	0x85, 0xdd, 0x81, 0xda, 0x07, 0x3b, 0xf4, 0x1c, 0x6f, 0x98, 0x94, 0xfc, 0x64, 0x6c, 0xdd, 0x82,
+	0x9d, 0x73, 0x4f, 0x8c, 0x83, 0xc0, 0x0f, 0x23, 0xde, 0x3f, 0x0a, 0x9c, 0x2f, 0xe2, 0x5d, 0x4e,
+	0x40, 0xad, 0xbf, 0xda, 0x00, 0x47, 0xa7, 0x5d, 0x2d, 0xc6, 0xcf, 0xa1, 0xa1, 0xeb, 0xe0, 0xec,
+	0xe7, 0x20, 0xce, 0x78, 0xf3, 0xf0, 0x7e, 0xbe, 0x42, 0xa6, 0x06, 0xa9, 0xbf, 0xd2, 0x80, 0xa5,
+	0xad, 0x65, 0x2e, 0xf5, 0xb0, 0xdb, 0x57, 0x19, 0x9b, 0x67, 0x53, 0x01, 0x9e, 0x03, 0x06, 0x05,
+	0x2e, 0xa2, 0xf6, 0xab, 0x71, 0x78, 0x37, 0xef, 0xd1, 0x48, 0x5c, 0x98, 0x01, 0x00, 0xdf, 0xc1,
+	0x7a, 0x50, 0x64, 0x32, 0xea, 0x58, 0x34, 0x0e, 0xef, 0x5d, 0x85, 0x1b, 0x6b, 0x33, 0x13, 0x04,
+	0xf6, 0x61, 0xd3, 0x31, 0xf3, 0x1c, 0x7d, 0x92, 0xf6, 0x0d, 0xe8, 0x25, 0xcc, 0x88, 0x95, 0x41,
+	0xe1, 0x10, 0xa8, 0x53, 0xc2, 0x97, 0xe8, 0xa2, 0x72, 0xf3, 0xbf, 0x6b, 0xb9, 0xd1, 0x2b, 0x29,
+	0x05, 0xc3, 0x13, 0x58, 0xb5, 0xb3, 0x6c, 0x8a, 0x2e, 0x29, 0x7c, 0xcb, 0x80, 0x9f, 0xe3, 0x5d,
+	0x2c, 0x6f, 0x8a, 0x6f, 0x80, 0xd8, 0x39, 0x16, 0x46, 0x6b, 0x0a, 0xee, 0xf6, 0x4c, 0x38, 0x1d,
+	0x66, 0xc1, 0x18, 0x3f, 0x83, 0x65, 0x91, 0x3a, 0x2a, 0xb4, 0xae, 0xc0, 0xb6, 0x4b, 0x38, 0x55,
+	0x1c, 0x55, 0xc6, 0x02, 0x9f, 0xc3, 0x8a, 0x48, 0x1f, 0x20, 0x0a, 0x0a, 0x62, 0x67, 0x26, 0x2d,
+	0x63, 0x59, 0x1b, 0xb9, 0x2e, 0x91, 0xa3, 0x08, 0xb4, 0x51, 0xba, 0xae, 0x3c, 0x9b, 0x60, 0x05,
+	0x63, 0x64, 0xb0, 0x26, 0xf2, 0xc4, 0x80, 0x2e, 0x2b, 0xc4, 0x3b, 0xb3, 0x11, 0x75, 0x80, 0x45,
+	0x73, 0xfc, 0x1a, 0x9a, 0x6e, 0x86, 0x08, 0xd0, 0x15, 0x05, 0xf8, 0x7f, 0x03, 0xe0, 0x2c, 0xfe,
+	0xc0, 0x72, 0x30, 0xf8, 0x2d, 0xac, 0xba, 0x59, 0x8a, 0x40, 0x9b, 0x0a, 0xf9, 0xe1, 0xf5, 0x91,
+	0x75, 0xd8, 0x79, 0x20, 0x7c, 0x94, 0xf4, 0xd2, 0x55, 0xf3, 0xb6, 0x64, 0x48, 0x47, 0xd2, 0x6a,
+	0xcf, 0x01, 0xed, 0x02, 0x4f, 0xa5, 0xa4, 0xb4, 0x69, 0x14, 0x49, 0x2d, 0x33, 0x00, 0xc8, 0xa6,
+	0x61, 0x17, 0x59, 0x2e, 0x5d, 0x2b, 0x6d, 0x1a, 0x06, 0x4e, 0xcc, 0x4c, 0x10, 0x38, 0x84, 0xad,
+	0xa0, 0x8c, 0x16, 0x51, 0x54, 0xf8, 0x85, 0xf6, 0x5a, 0xca, 0xa3, 0x58, 0x39, 0x16, 0xbe, 0x87,
+	0x4e, 0x50, 0x4a, 0x97, 0xe8, 0xba, 0xb9, 0x41, 0x95, 0x13, 0x2c, 0x36, 0x03, 0x0d, 0xbf, 0x83,
+	0x0d, 0x6e, 0x62, 0x49, 0xb4, 0x65, 0xde, 0x08, 0x23, 0xa5, 0x62, 0x66, 0x0c, 0xfc, 0x1e, 0xda,
+	0xdc, 0xf8, 0x6d, 0xa6, 0x1b, 0xe6, 0xed, 0x30, 0x7f, 0xc9, 0x59, 0x09, 0x0a, 0x32, 0xc0, 0x41,
+	0xe1, 0x4b, 0x4c, 0xdb, 0xe6, 0xd6, 0x57, 0xfc, 0x66, 0x33, 0x83, 0x35, 0x9e, 0xc3, 0xfa, 0xa0,
+	0xf8, 0xc5, 0xa6, 0x9b, 0xe6, 0x46, 0x61, 0xf8, 0xb8, 0x33, 0x93, 0x3d, 0x0a, 0xd8, 0x19, 0xcf,
+	0xfa, 0x7a, 0x53, 0xaa, 0x1c, 0x3c, 0xc8, 0x3b, 0x98, 0xf9, 0xc9, 0x67, 0xb3, 0x31, 0xf1, 0x1d,
+	0xb4, 0x1c, 0x75, 0x97, 0xc9, 0xed, 0xed, 0x96, 0xb9, 0x47, 0x75, 0x0d, 0xba, 0xcc, 0x88, 0x20,
+	0xcb, 0x26, 0x27, 0xd7, 0xcb, 0xe8, 0x98, 0xcb, 0xa6, 0x6b, 0x52, 0x66, 0x66, 0x0c, 0xeb, 0xf7,
+	0x2a, 0x34, 0xb3, 0x64, 0x04, 0xb7, 0x4a, 0x1e, 0x3c, 0xc8, 0x7f, 0xb0, 0x53, 0xf6, 0xe4, 0x41,
+	0x2a, 0x78, 0x73, 0xd6, 0x93, 0x06, 0x99, 0xc3, 0x5b, 0x33, 0x1f, 0x35, 0xc8, 0x3c, 0xb6, 0x4d,
+	0x4f, 0x15, 0x64, 0x21, 0x2b, 0x9f, 0xe8, 0x57, 0x91, 0x64, 0x5f, 0x1c, 0xc8, 0x22, 0xae, 0xe5,
+	0x1e, 0x17, 0xc8, 0x12, 0x6e, 0x1a, 0xaf, 0xab, 0xa4, 0x86, 0xd4, 0x7c, 0xd9, 0x24, 0x75, 0xbc,
+	0x7d, 0xe5, 0x85, 0x91, 0x00, 0xde, 0xb9, 0xfa, 0x5a, 0x48, 0x1a, 0x32, 0xa0, 0x4c, 0x3f, 0x26,
+	0xcb, 0x3a, 0xbb, 0xc5, 0x06, 0x4b, 0x56, 0x74, 0x76, 0x0d, 0x3d, 0x92, 0x34, 0x71, 0x67, 0xc6,
+	0x3d, 0x91, 0xac, 0xca, 0xe4, 0x97, 0x37, 0x25, 0x42, 0xa4, 0x57, 0x63, 0x37, 0x21, 0x6b, 0xd2,
+	0xab, 0xb9, 0x15, 0x10, 0x94, 0xa9, 0x2f, 0x1e, 0x65, 0xb2, 0x2e, 0xb3, 0x6a, 0x38, 0x8d, 0xa4,
+	0x85, 0xff, 0xbd, 0x82, 0x38, 0x93, 0x0d, 0x99, 0x78, 0x53, 0xf1, 0x93, 0xb6, 0x0c, 0xd2, 0x58,
+	0xbb, 0x64, 0xd3, 0xfa, 0xad, 0x62, 0xb6, 0x92, 0x4f, 0x6d, 0xfa, 0xce, 0x96, 0x7a, 0x7a, 0x48,
+	0x8b, 0x32, 0x17, 0xae, 0xb9, 0xdc, 0x85, 0xeb, 0x8a, 0xc7, 0x0e, 0xf9, 0xd2, 0x16, 0xf2, 0x91,
+	0xff, 0x13, 0x7f, 0xc1, 0x07, 0x8e, 0xe7, 0xc8, 0xa2, 0x55, 0x94, 0xb8, 0xc6, 0x0a, 0x72, 0x6b,
+	0x54, 0x12, 0xfd, 0xbf, 0x73, 0xe7, 0x7e, 0x76, 0x1f, 0xda, 0x3d, 0x7f, 0x74, 0x10, 0x5d, 0xfa,
+	0xe3, 0xe1, 0x65, 0xf4, 0xc1, 0x0f, 0x7f, 0x10, 0x71, 0x23, 0xf8, 0x63, 0xae, 0x79, 0x2c, 0x7f,
+	0x0f, 0x74, 0xea, 0xc5, 0xc5, 0xa2, 0x7a, 0xc8, 0x7c, 0xf4, 0xf7, 0x00, 0x27, 0x66, 0x0e, 0xaa,
+	0xf1, 0x14, 0x00, 0x00,
 }
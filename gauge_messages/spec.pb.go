@@ -1318,12 +1318,14 @@ type ProtoStepExecutionResult struct {
 	// / Contains a 'before' hook failure message. This happens when the `before_step` hook has an error.
 	PreHookFailure *ProtoHookFailure `protobuf:"bytes,2,opt,name=preHookFailure,proto3" json:"preHookFailure,omitempty"`
 	// / Contains a 'after' hook failure message. This happens when the `after_step` hook has an error.
-	PostHookFailure      *ProtoHookFailure `protobuf:"bytes,3,opt,name=postHookFailure,proto3" json:"postHookFailure,omitempty"`
-	Skipped              bool              `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
-	SkippedReason        string            `protobuf:"bytes,5,opt,name=skippedReason,proto3" json:"skippedReason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	PostHookFailure *ProtoHookFailure `protobuf:"bytes,3,opt,name=postHookFailure,proto3" json:"postHookFailure,omitempty"`
+	Skipped         bool              `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	SkippedReason   string            `protobuf:"bytes,5,opt,name=skippedReason,proto3" json:"skippedReason,omitempty"`
+	// / The output written by the runner while the step was executed
+	Stdout               string   `protobuf:"bytes,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProtoStepExecutionResult) Reset()         { *m = ProtoStepExecutionResult{} }
@@ -1386,6 +1388,13 @@ func (m *ProtoStepExecutionResult) GetSkippedReason() string {
 	return ""
 }
 
+func (m *ProtoStepExecutionResult) GetStdout() string {
+	if m != nil {
+		return m.Stdout
+	}
+	return ""
+}
+
 // / A proto object representing the result of an execution
 type ProtoExecutionResult struct {
 	// / Flag to indicate failure
@@ -2082,133 +2091,133 @@ func init() {
 func init() { proto.RegisterFile("spec.proto", fileDescriptor_423806180556987f) }
 
 var fileDescriptor_423806180556987f = []byte{
-	// 2043 bytes of a gzipped FileDescriptorProto
+	// 2042 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6e, 0x23, 0x49,
-	0xf5, 0x4f, 0xbb, 0xed, 0xd8, 0x3e, 0xfe, 0x48, 0xa7, 0x92, 0x9d, 0x7f, 0xff, 0x47, 0xc3, 0x8c,
-	0xd5, 0xca, 0x6a, 0xcd, 0x68, 0x36, 0x0c, 0x59, 0x98, 0x15, 0x42, 0x02, 0x65, 0x63, 0x67, 0xc7,
-	0x30, 0x9b, 0x89, 0xca, 0x66, 0x84, 0xf6, 0x66, 0xe9, 0xe9, 0x54, 0x92, 0xde, 0xd8, 0xdd, 0x56,
-	0x77, 0x79, 0x26, 0xbb, 0x0f, 0xc0, 0x03, 0x70, 0xc3, 0x3b, 0x70, 0xcb, 0x0b, 0x20, 0x21, 0x71,
-	0x83, 0xb4, 0x8f, 0x00, 0x57, 0xdc, 0xf0, 0x10, 0x08, 0xd5, 0xa9, 0xaa, 0xfe, 0x72, 0x3b, 0xb1,
-	0x11, 0x17, 0xdc, 0x55, 0x9d, 0x8f, 0xaa, 0x3a, 0x55, 0xe7, 0xe3, 0x77, 0x0a, 0x20, 0x9e, 0x33,
-	0xef, 0x70, 0x1e, 0x85, 0x3c, 0x24, 0xdd, 0x2b, 0x77, 0x71, 0xc5, 0x0e, 0x67, 0x2c, 0x8e, 0xdd,
-	0x2b, 0x16, 0x3b, 0xff, 0xaa, 0x42, 0xf3, 0x5c, 0x70, 0xc6, 0x73, 0xe6, 0x91, 0x1e, 0xb4, 0x84,
-	0xec, 0x4b, 0xe6, 0x5e, 0xf8, 0xc1, 0x95, 0x6d, 0xf4, 0x8c, 0x7e, 0x93, 0x66, 0x49, 0xe4, 0x07,
-	0x50, 0xf3, 0x39, 0x9b, 0xc5, 0x76, 0xa5, 0x67, 0xf6, 0x5b, 0x47, 0xff, 0x7f, 0x98, 0x5f, 0xef,
-	0x10, 0xd7, 0x1a, 0x71, 0x36, 0xa3, 0x52, 0x8e, 0x1c, 0x40, 0xc7, 0x8f, 0x27, 0xee, 0xdb, 0x29,
-	0x1b, 0x44, 0xfe, 0x3b, 0x16, 0xd8, 0x66, 0xcf, 0xe8, 0x37, 0x68, 0x9e, 0x48, 0x7e, 0x01, 0x3b,
-	0xf3, 0x88, 0xbd, 0x0c, 0xc3, 0x9b, 0x53, 0xd7, 0x9f, 0x2e, 0x22, 0x16, 0xdb, 0x55, 0xdc, 0xa0,
-	0x57, 0xba, 0x41, 0x46, 0x90, 0x16, 0x15, 0xc9, 0x2b, 0xb0, 0xe6, 0x61, 0xcc, 0x73, 0x8b, 0xd5,
-	0xd6, 0x5c, 0x6c, 0x49, 0x93, 0x3c, 0x84, 0xc6, 0xa5, 0x3f, 0x65, 0x67, 0xee, 0x8c, 0xd9, 0xdb,
-	0x78, 0x1f, 0xc9, 0x9c, 0x10, 0xa8, 0x72, 0xf7, 0x2a, 0xb6, 0xeb, 0x3d, 0xb3, 0xdf, 0xa4, 0x38,
-	0x26, 0xfd, 0xc4, 0x92, 0x2f, 0xd4, 0x2e, 0x76, 0x03, 0xd9, 0x45, 0x32, 0x79, 0x9a, 0x9e, 0x33,
-	0x11, 0x6d, 0xa2, 0xe8, 0x12, 0x9d, 0x3c, 0x85, 0x6e, 0x5e, 0xdd, 0x06, 0x21, 0xf9, 0x59, 0xc5,
-	0x36, 0x68, 0x81, 0x43, 0x9e, 0xc1, 0x4e, 0x41, 0xdf, 0x6e, 0x25, 0xc2, 0x45, 0x16, 0x39, 0x04,
-	0xa2, 0xf4, 0xc7, 0x5e, 0xc4, 0x58, 0x10, 0x5f, 0x87, 0x3c, 0xb6, 0xdb, 0x3d, 0xb3, 0xdf, 0xa6,
-	0x25, 0x1c, 0xf2, 0x1c, 0xf6, 0xf4, 0x12, 0x59, 0x85, 0x0e, 0x2a, 0x94, 0xb1, 0xc8, 0x23, 0x68,
-	0x0a, 0x57, 0x38, 0x09, 0x17, 0x01, 0xb7, 0xbb, 0x3d, 0xa3, 0x6f, 0xd2, 0x94, 0xe0, 0xfc, 0x53,
-	0x3b, 0xa0, 0x70, 0x1a, 0xf2, 0x33, 0x68, 0x08, 0xd6, 0xe4, 0x9b, 0x39, 0x43, 0xef, 0xeb, 0x1e,
-	0x39, 0x2b, 0x3d, 0xec, 0x70, 0xa4, 0x24, 0x69, 0xa2, 0x43, 0x3e, 0x86, 0x6a, 0xcc, 0xd9, 0xdc,
-	0xae, 0xf4, 0x8c, 0x95, 0xde, 0x39, 0xe6, 0x6c, 0x4e, 0x51, 0x8c, 0xbc, 0x80, 0xba, 0x17, 0x06,
-	0x1e, 0x9b, 0x73, 0x74, 0xcb, 0xd6, 0xd1, 0xa3, 0x52, 0x8d, 0x13, 0x29, 0x43, 0xb5, 0x30, 0xf9,
-	0x09, 0x34, 0x62, 0x8f, 0x05, 0x6e, 0xe4, 0x87, 0x76, 0x15, 0x15, 0xbf, 0x57, 0xbe, 0x95, 0x12,
-	0xa2, 0x89, 0x38, 0xf9, 0x12, 0xf6, 0x78, 0xea, 0xf8, 0x5a, 0xc0, 0xae, 0xe1, 0x2a, 0xfd, 0xd2,
-	0x55, 0x26, 0xcb, 0xf2, 0xb4, 0x6c, 0x11, 0x69, 0xce, 0x6c, 0xc6, 0x02, 0x8e, 0xae, 0xba, 0xda,
-	0x1c, 0x94, 0xa1, 0x5a, 0x98, 0x3c, 0x87, 0x1a, 0x2e, 0x67, 0xd7, 0x51, 0xeb, 0xe1, 0xea, 0x53,
-	0x50, 0x29, 0x28, 0xee, 0x19, 0x3d, 0xbf, 0x71, 0xc7, 0x3d, 0x4f, 0xdc, 0xab, 0x58, 0x05, 0x45,
-	0x36, 0x88, 0x9a, 0xf9, 0x20, 0x72, 0xbe, 0x86, 0x86, 0x7e, 0x48, 0xd2, 0x80, 0xaa, 0x78, 0x1d,
-	0x6b, 0x8b, 0xb4, 0xa0, 0xae, 0x8e, 0x69, 0x19, 0x72, 0x82, 0x37, 0x6f, 0x55, 0x48, 0x1b, 0x1a,
-	0xda, 0x60, 0xcb, 0x24, 0xff, 0x07, 0x7b, 0x25, 0xd7, 0x63, 0x55, 0x49, 0x13, 0x6a, 0xc8, 0xb0,
-	0x6a, 0x62, 0x55, 0x71, 0x16, 0x6b, 0xdb, 0xf9, 0x63, 0x1d, 0x3a, 0xb9, 0x87, 0x11, 0xe1, 0xaa,
-	0x9f, 0x26, 0x9f, 0xf5, 0x8a, 0x64, 0xf2, 0x10, 0xb6, 0x2f, 0x5d, 0x7f, 0xca, 0x2e, 0xd0, 0xb9,
-	0x1a, 0x18, 0x4d, 0x8a, 0x42, 0x7e, 0x0c, 0x0d, 0x2f, 0x0c, 0x38, 0xbb, 0xe5, 0xb1, 0x6d, 0xde,
-	0x97, 0x18, 0x13, 0x51, 0xf2, 0x73, 0xe8, 0xe8, 0x5d, 0x46, 0x98, 0x54, 0xab, 0xf7, 0xe9, 0xe6,
-	0xe5, 0xc9, 0xcb, 0x24, 0x2d, 0xa8, 0x7c, 0xa5, 0xfc, 0xe8, 0xfe, 0x44, 0x57, 0xd0, 0xc3, 0x04,
-	0x9c, 0x4f, 0x7d, 0xca, 0x85, 0xd6, 0x49, 0xc0, 0x79, 0xc5, 0xd2, 0xb4, 0x78, 0x00, 0x1d, 0x76,
-	0xcb, 0xbc, 0x05, 0xf7, 0xc3, 0x60, 0xe2, 0xcf, 0x18, 0x7a, 0x8e, 0x49, 0xf3, 0x44, 0xf2, 0x08,
-	0xea, 0xf1, 0x8d, 0x3f, 0x9f, 0xb3, 0x0b, 0x74, 0x13, 0x79, 0xc9, 0x9a, 0x44, 0x1e, 0x03, 0x88,
-	0xe1, 0x30, 0x8a, 0xc2, 0x28, 0x96, 0x09, 0x90, 0x66, 0x28, 0xa4, 0x0b, 0x95, 0xd1, 0xc0, 0x6e,
-	0xe1, 0xf3, 0x55, 0x46, 0x03, 0x71, 0xbd, 0x9c, 0xb9, 0xd1, 0x20, 0x7c, 0x1f, 0x08, 0xaf, 0x92,
-	0x59, 0xed, 0xee, 0xeb, 0xcd, 0xc9, 0x93, 0x3e, 0x54, 0xe3, 0xb9, 0x1b, 0xd8, 0x1d, 0xbc, 0x89,
-	0xfd, 0xa2, 0xde, 0x78, 0xee, 0x06, 0x14, 0x25, 0xc8, 0x08, 0x76, 0x12, 0x4b, 0xc6, 0xdc, 0xe5,
-	0x8b, 0x18, 0x33, 0x5d, 0xf7, 0xe8, 0x49, 0x51, 0x69, 0x98, 0x17, 0xa3, 0x45, 0xbd, 0xb2, 0x02,
-	0xb2, 0xb3, 0x7e, 0x01, 0xb1, 0xd6, 0x2e, 0x20, 0xbb, 0x9b, 0x14, 0x10, 0xb2, 0x69, 0x01, 0xd9,
-	0xdb, 0xb4, 0x80, 0xec, 0xaf, 0x2c, 0x20, 0xce, 0x25, 0x54, 0xc5, 0x55, 0x93, 0x7d, 0xa8, 0xc5,
-	0xdc, 0x8d, 0x38, 0x46, 0xa8, 0x49, 0xe5, 0x84, 0x58, 0x60, 0xb2, 0x40, 0x06, 0xa5, 0x49, 0xc5,
-	0x50, 0x14, 0x1c, 0x64, 0x9d, 0x5c, 0xbb, 0x11, 0xe6, 0x75, 0x93, 0xa6, 0x04, 0x62, 0x43, 0x9d,
-	0x05, 0x17, 0xc8, 0xab, 0x22, 0x4f, 0x4f, 0x9d, 0xbf, 0x57, 0xc0, 0x5e, 0x95, 0x70, 0x73, 0x29,
-	0xdf, 0xd8, 0x2c, 0xe5, 0x1f, 0x40, 0x07, 0xb3, 0x26, 0x0d, 0xdf, 0x8f, 0x82, 0x0b, 0x76, 0x8b,
-	0x67, 0xad, 0xd1, 0x3c, 0x91, 0xfc, 0x08, 0x3e, 0xd0, 0x1a, 0x93, 0x9c, 0xb4, 0x89, 0xd2, 0xe5,
-	0x4c, 0xf2, 0x0c, 0x76, 0xfd, 0x58, 0x60, 0xb7, 0x2c, 0xc4, 0xaa, 0x22, 0xc4, 0x5a, 0x66, 0x88,
-	0x3d, 0xfc, 0x78, 0x9c, 0x5d, 0x48, 0x69, 0xd4, 0x50, 0xa3, 0x9c, 0x49, 0x5e, 0xc2, 0xae, 0xde,
-	0x7c, 0xe0, 0x72, 0x17, 0x59, 0x2a, 0x3b, 0xdc, 0x55, 0x2a, 0x96, 0x95, 0x9c, 0xdf, 0x9b, 0x1a,
-	0x6d, 0x8a, 0xea, 0xfb, 0x18, 0xc0, 0xf5, 0xf8, 0xc2, 0x9d, 0x4e, 0xd8, 0x2d, 0x57, 0x69, 0x37,
-	0x43, 0x11, 0xfc, 0xb9, 0x1b, 0xc5, 0xec, 0x02, 0xf9, 0x15, 0xc9, 0x4f, 0x29, 0xe4, 0x05, 0x34,
-	0x2f, 0x23, 0xf7, 0x4a, 0x14, 0x09, 0x9d, 0x76, 0xed, 0xe2, 0x79, 0x4e, 0x95, 0x00, 0x4d, 0x45,
-	0x45, 0x09, 0x16, 0xd5, 0x3f, 0x89, 0x44, 0xca, 0xe2, 0xc5, 0x94, 0xab, 0x42, 0xde, 0x5f, 0x89,
-	0x19, 0x0a, 0xf2, 0xb4, 0x6c, 0x91, 0xb2, 0xe8, 0xad, 0xad, 0x1f, 0xbd, 0xdb, 0x2b, 0xa2, 0xb7,
-	0x3c, 0xc6, 0xea, 0x9b, 0xc6, 0x58, 0x63, 0x75, 0x8c, 0xfd, 0xcd, 0x80, 0x76, 0x16, 0xeb, 0x90,
-	0x9f, 0x42, 0x4b, 0xa1, 0x1d, 0x61, 0xbb, 0x72, 0xf9, 0x3b, 0x00, 0x55, 0x56, 0x5a, 0x74, 0x09,
-	0x31, 0x66, 0xdc, 0xfb, 0xbb, 0x04, 0x94, 0x23, 0xbf, 0x81, 0x07, 0x4a, 0xbf, 0xf8, 0x2a, 0xe6,
-	0x86, 0xaf, 0xb2, 0x62, 0x1d, 0xe7, 0x89, 0xf2, 0x3c, 0x81, 0x04, 0x92, 0x0a, 0x65, 0xa4, 0x15,
-	0xca, 0xf9, 0xab, 0x01, 0x0d, 0xed, 0x2d, 0x64, 0x04, 0x6d, 0xed, 0x2f, 0x19, 0x2c, 0xfa, 0xe1,
-	0x2a, 0xef, 0x4a, 0x06, 0x08, 0x47, 0x73, 0xaa, 0xb8, 0x57, 0xea, 0xbf, 0x38, 0x26, 0x9f, 0x42,
-	0x73, 0xee, 0x46, 0xee, 0x8c, 0x71, 0x16, 0x29, 0x0b, 0x97, 0xef, 0x48, 0x0b, 0xd0, 0x54, 0xd6,
-	0xf9, 0x08, 0xda, 0xd9, 0xad, 0x10, 0xda, 0xb0, 0x5b, 0x6e, 0x6d, 0x91, 0x0e, 0x34, 0x13, 0x0d,
-	0xcb, 0x70, 0x7e, 0x57, 0xc9, 0xcc, 0xc9, 0x17, 0xd0, 0x49, 0xd6, 0xc8, 0xd8, 0xf3, 0xd1, 0xca,
-	0x3d, 0xd3, 0x11, 0x5a, 0x94, 0xd7, 0x16, 0x89, 0xf8, 0x9d, 0x3b, 0x5d, 0x30, 0x65, 0x93, 0x9c,
-	0x08, 0x43, 0x03, 0x01, 0xf0, 0x4c, 0x69, 0xa8, 0x18, 0xa7, 0xc8, 0xb2, 0xba, 0x26, 0xb2, 0x74,
-	0xbe, 0x84, 0x4e, 0x6e, 0x6f, 0x02, 0xb0, 0x2d, 0x2a, 0xa3, 0xef, 0x49, 0x54, 0x38, 0xf8, 0x26,
-	0x70, 0x67, 0xbe, 0x67, 0x19, 0x84, 0x40, 0x57, 0xe4, 0x37, 0xdf, 0x9d, 0x7e, 0x35, 0xe6, 0x91,
-	0x1f, 0x5c, 0x59, 0x15, 0xb2, 0x0b, 0x1d, 0x4d, 0x93, 0xe8, 0xcf, 0x4c, 0x81, 0x60, 0xd5, 0x71,
-	0x12, 0x1f, 0x97, 0xb8, 0x57, 0x3f, 0x8d, 0x91, 0x3e, 0x8d, 0x73, 0x0b, 0x90, 0x1e, 0x8a, 0x7c,
-	0x0a, 0xf5, 0x6b, 0xe6, 0x5e, 0xb0, 0x28, 0xbe, 0x33, 0xe9, 0xeb, 0x9c, 0x4c, 0xb5, 0x34, 0xf9,
-	0x21, 0x54, 0xa3, 0xf0, 0xbd, 0x0e, 0x80, 0x7b, 0xb4, 0x50, 0xd4, 0xf9, 0x50, 0x61, 0x53, 0x4d,
-	0x16, 0xd7, 0xec, 0xb1, 0xe9, 0x54, 0xbb, 0xa9, 0x9c, 0x38, 0x7f, 0xd2, 0x55, 0xaa, 0xc4, 0xfb,
-	0xc9, 0x59, 0x06, 0x87, 0xa8, 0x00, 0x92, 0xe7, 0x3e, 0x28, 0x3d, 0x41, 0x31, 0x78, 0x8a, 0xca,
-	0x25, 0x00, 0xb3, 0xf2, 0xdf, 0x03, 0x98, 0xe6, 0x7f, 0x0a, 0x30, 0xed, 0x14, 0x26, 0xca, 0x52,
-	0x97, 0x40, 0xc4, 0x03, 0xe8, 0xa8, 0x21, 0x65, 0x6e, 0x1c, 0xca, 0xc2, 0xd6, 0xa4, 0x79, 0xa2,
-	0xf3, 0x9d, 0x09, 0xfb, 0x65, 0xf6, 0x93, 0x07, 0x09, 0xc6, 0x37, 0x70, 0x5d, 0x8d, 0xef, 0x9f,
-	0x82, 0x15, 0x31, 0x2f, 0x7c, 0xc7, 0x22, 0xf1, 0x36, 0x08, 0x37, 0x65, 0x17, 0x40, 0x97, 0xe8,
-	0xc4, 0x81, 0x36, 0x13, 0x03, 0x0d, 0x9d, 0x64, 0x38, 0xe4, 0x68, 0x88, 0x64, 0xb9, 0xeb, 0xdd,
-	0x4c, 0x22, 0xd7, 0x93, 0xb1, 0x21, 0x90, 0x6c, 0x42, 0x21, 0x0e, 0x40, 0x8c, 0xc9, 0x79, 0x7c,
-	0x1d, 0x72, 0xb4, 0xa1, 0x8d, 0xe0, 0x2b, 0x43, 0x5d, 0x46, 0xd4, 0xdb, 0x65, 0x88, 0xda, 0x86,
-	0xba, 0xba, 0x58, 0x05, 0xc7, 0xf5, 0x94, 0xbc, 0x82, 0x26, 0x9e, 0x09, 0xf3, 0x41, 0x03, 0xf3,
-	0xc1, 0xe1, 0x3a, 0x4e, 0x72, 0x38, 0xd4, 0x5a, 0x34, 0x5d, 0x40, 0xe0, 0x90, 0x4b, 0xf9, 0x3a,
-	0x69, 0x55, 0x41, 0x0c, 0xdf, 0xa6, 0xcb, 0x0c, 0xfc, 0x67, 0xca, 0xd4, 0x25, 0xc0, 0xba, 0x94,
-	0x25, 0x39, 0xcf, 0xa0, 0x99, 0xec, 0x23, 0x72, 0xdb, 0xf1, 0x78, 0x3c, 0xa4, 0x93, 0xd1, 0xeb,
-	0x33, 0x6b, 0x8b, 0x58, 0xd0, 0x7e, 0x33, 0xa4, 0xa3, 0xd3, 0xd1, 0xc9, 0x31, 0x52, 0x0c, 0xe7,
-	0x3b, 0x03, 0xac, 0xa2, 0xdb, 0x14, 0x2e, 0xd9, 0x28, 0xb9, 0xe4, 0xfc, 0x43, 0x55, 0x4a, 0x1e,
-	0x2a, 0xff, 0x10, 0xe6, 0xaa, 0x87, 0xc8, 0xc3, 0xbb, 0x6a, 0x19, 0xbc, 0x2b, 0xbd, 0xa0, 0xda,
-	0x8a, 0x0b, 0x72, 0xfe, 0xb1, 0xad, 0x0c, 0x1a, 0x2f, 0x7c, 0xce, 0x94, 0x77, 0x1e, 0xcb, 0xdf,
-	0x39, 0x39, 0x93, 0x59, 0xa1, 0xb5, 0xdc, 0x60, 0x24, 0xbf, 0x79, 0x2a, 0xa6, 0xb3, 0x3a, 0xff,
-	0xa3, 0xf1, 0x9c, 0x86, 0x5d, 0xb5, 0x18, 0x76, 0xe2, 0xf0, 0xf1, 0x29, 0x4e, 0xe5, 0x07, 0x52,
-	0x0d, 0x2f, 0x77, 0x89, 0xbe, 0x66, 0x38, 0x08, 0xc7, 0x5b, 0x78, 0x1e, 0x8b, 0x63, 0xea, 0x72,
-	0xf9, 0xdf, 0x51, 0xa1, 0x59, 0x92, 0x90, 0x60, 0xc1, 0x3b, 0x3f, 0x0a, 0x03, 0xfc, 0x47, 0x69,
-	0xc8, 0x2f, 0xd0, 0x0c, 0x29, 0x01, 0x0f, 0x4d, 0x55, 0x35, 0x04, 0xa0, 0xe8, 0x41, 0x6b, 0x1e,
-	0x85, 0x5f, 0x33, 0x8f, 0xe3, 0x1f, 0x07, 0x48, 0xad, 0x0c, 0x49, 0x34, 0x25, 0xdc, 0x9f, 0xb1,
-	0x98, 0xbb, 0xb3, 0xb9, 0xea, 0x51, 0x53, 0x82, 0xf0, 0x0e, 0xb4, 0x68, 0x2c, 0xf3, 0x94, 0x34,
-	0xb5, 0x8d, 0xa6, 0x2e, 0x33, 0xca, 0x40, 0x66, 0x67, 0x7d, 0x90, 0xd9, 0x5d, 0xbb, 0x45, 0xdc,
-	0xd9, 0xa4, 0x45, 0xb4, 0x36, 0x6d, 0x11, 0x77, 0x37, 0x85, 0xaf, 0x64, 0xf5, 0x1f, 0xa3, 0x0d,
-	0x75, 0xef, 0x7a, 0x11, 0xdc, 0xb0, 0x0b, 0x7b, 0x4f, 0x56, 0x04, 0x35, 0x15, 0xf7, 0x8e, 0xc3,
-	0xb1, 0xff, 0x2d, 0xb3, 0xf7, 0x65, 0x33, 0x98, 0x10, 0x9c, 0x3f, 0x9b, 0xb0, 0x53, 0x08, 0x18,
-	0x04, 0x67, 0x9a, 0x74, 0x37, 0xee, 0x15, 0x3a, 0xa9, 0x2c, 0x16, 0x1f, 0xd5, 0xf2, 0xc8, 0x07,
-	0x54, 0x7d, 0x5e, 0x8e, 0x28, 0x8c, 0xd3, 0x84, 0xac, 0x5f, 0xcb, 0x2e, 0xaf, 0x8c, 0xb5, 0x32,
-	0x3c, 0x9e, 0xc3, 0x9e, 0x1c, 0x25, 0x0d, 0x16, 0x15, 0x90, 0x43, 0xf4, 0x1b, 0x35, 0x5a, 0xc6,
-	0x5a, 0xbf, 0x66, 0xe8, 0xf2, 0x5a, 0xcf, 0x97, 0xd7, 0x23, 0xd8, 0xd7, 0x07, 0xcc, 0x79, 0x6a,
-	0x03, 0x0f, 0x5f, 0xca, 0x43, 0x1d, 0x39, 0xcf, 0x1f, 0xb3, 0x89, 0xc7, 0x2c, 0xe5, 0x91, 0x8f,
-	0x61, 0x9b, 0xa5, 0xbf, 0x3c, 0xad, 0xa3, 0x0f, 0x96, 0x7e, 0x51, 0x04, 0x97, 0x2a, 0x21, 0xe7,
-	0x2f, 0x06, 0xd4, 0x64, 0xf1, 0xfd, 0x04, 0xaa, 0x3c, 0xc5, 0xb7, 0x4f, 0x4a, 0xd5, 0x32, 0x05,
-	0x0c, 0x85, 0xf5, 0xef, 0x24, 0x82, 0xd7, 0x4a, 0xfa, 0x3b, 0x89, 0x00, 0xf6, 0x31, 0xc0, 0xd4,
-	0x0f, 0xd8, 0xd9, 0x62, 0xf6, 0x56, 0x41, 0xf5, 0x1a, 0xcd, 0x50, 0xb2, 0xf5, 0x55, 0x96, 0x71,
-	0x3d, 0x75, 0x8e, 0xb2, 0x15, 0x6c, 0x07, 0x5a, 0xe7, 0xc7, 0x74, 0x3c, 0xfc, 0x6a, 0x48, 0xe9,
-	0x6b, 0x6a, 0x6d, 0x91, 0x7d, 0xb0, 0xde, 0x1c, 0xbf, 0x1a, 0x0d, 0xb0, 0x82, 0x29, 0xaa, 0xe1,
-	0xfc, 0xd6, 0x80, 0x6e, 0x82, 0xed, 0xde, 0x20, 0xaa, 0xc6, 0xcf, 0x0c, 0x35, 0x51, 0x45, 0x2c,
-	0x25, 0x90, 0x17, 0xf0, 0x20, 0x81, 0xe6, 0xfe, 0xb7, 0xec, 0x22, 0xd1, 0x53, 0x86, 0xac, 0xe0,
-	0xaa, 0xd6, 0x5a, 0x72, 0x64, 0xef, 0x2c, 0x5b, 0x6b, 0x45, 0x79, 0xfa, 0x39, 0xec, 0x14, 0x3e,
-	0xaa, 0x84, 0x09, 0x67, 0xaf, 0x27, 0xc3, 0x5f, 0x0f, 0x4f, 0x7e, 0x35, 0x19, 0x0e, 0xac, 0x2d,
-	0x01, 0xcc, 0xcf, 0x45, 0x59, 0x1e, 0x58, 0x86, 0x18, 0x9f, 0x1e, 0x8f, 0x5e, 0x0d, 0x07, 0x56,
-	0x45, 0x80, 0xf4, 0xf1, 0x2f, 0x47, 0xe7, 0xe7, 0xc3, 0x81, 0x65, 0x7e, 0xf6, 0x7d, 0xd1, 0xd8,
-	0xcd, 0x0e, 0xf9, 0x75, 0xb8, 0xb8, 0xba, 0xe6, 0xef, 0xc3, 0xe8, 0x26, 0x96, 0x8f, 0xf2, 0x87,
-	0x4a, 0xf7, 0x73, 0x7c, 0x1c, 0x9d, 0x7f, 0xde, 0x6e, 0x63, 0x24, 0x7d, 0xf2, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x62, 0x96, 0xcc, 0xd0, 0xaf, 0x1a, 0x00, 0x00,
+	0x15, 0x4e, 0xbb, 0xdb, 0x7f, 0xc7, 0x3f, 0xe9, 0x54, 0xb2, 0x43, 0x33, 0x1a, 0x76, 0xac, 0xd6,
+	0xac, 0x36, 0x8c, 0x66, 0xcd, 0x90, 0x85, 0x59, 0x21, 0x24, 0x50, 0x36, 0x76, 0x76, 0x0c, 0xb3,
+	0x99, 0xa8, 0x6c, 0x46, 0x68, 0x6f, 0x96, 0x9e, 0x76, 0x25, 0xe9, 0x8d, 0xdd, 0x6d, 0x75, 0x97,
+	0x27, 0xd9, 0x7d, 0x00, 0x1e, 0x80, 0x1b, 0xde, 0x81, 0x5b, 0x1e, 0x01, 0x89, 0x1b, 0xa4, 0xbd,
+	0xe6, 0x0a, 0xae, 0xb8, 0xe1, 0x21, 0x10, 0xaa, 0x53, 0xd5, 0xbf, 0x6e, 0x27, 0x36, 0xe2, 0x62,
+	0xef, 0xaa, 0xce, 0xf9, 0x4e, 0xfd, 0x9e, 0x9f, 0xaf, 0x0a, 0x20, 0x5a, 0x30, 0xb7, 0xbf, 0x08,
+	0x03, 0x1e, 0x90, 0xee, 0xa5, 0xb3, 0xbc, 0x64, 0xfd, 0x39, 0x8b, 0x22, 0xe7, 0x92, 0x45, 0xf6,
+	0x7f, 0x0c, 0x68, 0x9e, 0x0b, 0xcd, 0x78, 0xc1, 0x5c, 0xd2, 0x83, 0x96, 0xc0, 0xbe, 0x64, 0xce,
+	0xd4, 0xf3, 0x2f, 0x2d, 0xad, 0xa7, 0x1d, 0x36, 0x69, 0x56, 0x44, 0x7e, 0x04, 0x55, 0x8f, 0xb3,
+	0x79, 0x64, 0x55, 0x7a, 0xfa, 0x61, 0xeb, 0xe8, 0xfb, 0xfd, 0xfc, 0x78, 0x7d, 0x1c, 0x6b, 0xc4,
+	0xd9, 0x9c, 0x4a, 0x1c, 0x79, 0x02, 0x1d, 0x2f, 0x9a, 0x38, 0x6f, 0x67, 0x6c, 0x10, 0x7a, 0xef,
+	0x98, 0x6f, 0xe9, 0x3d, 0xed, 0xb0, 0x41, 0xf3, 0x42, 0xf2, 0x2b, 0xd8, 0x5d, 0x84, 0xec, 0x65,
+	0x10, 0x5c, 0x9f, 0x3a, 0xde, 0x6c, 0x19, 0xb2, 0xc8, 0x32, 0x70, 0x82, 0x5e, 0xe9, 0x04, 0x19,
+	0x20, 0x2d, 0x1a, 0x92, 0x57, 0x60, 0x2e, 0x82, 0x88, 0xe7, 0x06, 0xab, 0x6e, 0x38, 0xd8, 0x8a,
+	0x25, 0x79, 0x08, 0x8d, 0x0b, 0x6f, 0xc6, 0xce, 0x9c, 0x39, 0xb3, 0x6a, 0x78, 0x1e, 0x49, 0x9f,
+	0x10, 0x30, 0xb8, 0x73, 0x19, 0x59, 0xf5, 0x9e, 0x7e, 0xd8, 0xa4, 0xd8, 0x26, 0x87, 0xc9, 0x4e,
+	0x3e, 0x57, 0xb3, 0x58, 0x0d, 0x54, 0x17, 0xc5, 0xe4, 0x69, 0xba, 0xce, 0x04, 0xda, 0x44, 0xe8,
+	0x8a, 0x9c, 0x3c, 0x85, 0x6e, 0xde, 0xdc, 0x02, 0x81, 0xfc, 0xb4, 0x62, 0x69, 0xb4, 0xa0, 0x21,
+	0xcf, 0x60, 0xb7, 0x60, 0x6f, 0xb5, 0x12, 0x70, 0x51, 0x45, 0xfa, 0x40, 0x94, 0xfd, 0xd8, 0x0d,
+	0x19, 0xf3, 0xa3, 0xab, 0x80, 0x47, 0x56, 0xbb, 0xa7, 0x1f, 0xb6, 0x69, 0x89, 0x86, 0x3c, 0x87,
+	0xfd, 0x78, 0x88, 0xac, 0x41, 0x07, 0x0d, 0xca, 0x54, 0xe4, 0x11, 0x34, 0x85, 0x2b, 0x9c, 0x04,
+	0x4b, 0x9f, 0x5b, 0xdd, 0x9e, 0x76, 0xa8, 0xd3, 0x54, 0x60, 0xff, 0x3b, 0x76, 0x40, 0xe1, 0x34,
+	0xe4, 0x17, 0xd0, 0x10, 0xaa, 0xc9, 0xd7, 0x0b, 0x86, 0xde, 0xd7, 0x3d, 0xb2, 0xd7, 0x7a, 0x58,
+	0x7f, 0xa4, 0x90, 0x34, 0xb1, 0x21, 0x1f, 0x81, 0x11, 0x71, 0xb6, 0xb0, 0x2a, 0x3d, 0x6d, 0xad,
+	0x77, 0x8e, 0x39, 0x5b, 0x50, 0x84, 0x91, 0x17, 0x50, 0x77, 0x03, 0xdf, 0x65, 0x0b, 0x8e, 0x6e,
+	0xd9, 0x3a, 0x7a, 0x54, 0x6a, 0x71, 0x22, 0x31, 0x34, 0x06, 0x93, 0x9f, 0x41, 0x23, 0x72, 0x99,
+	0xef, 0x84, 0x5e, 0x60, 0x19, 0x68, 0xf8, 0x83, 0xf2, 0xa9, 0x14, 0x88, 0x26, 0x70, 0xf2, 0x05,
+	0xec, 0xf3, 0xd4, 0xf1, 0x63, 0x80, 0x55, 0xc5, 0x51, 0x0e, 0x4b, 0x47, 0x99, 0xac, 0xe2, 0x69,
+	0xd9, 0x20, 0x72, 0x3b, 0xf3, 0x39, 0xf3, 0xb9, 0x55, 0xbb, 0x73, 0x3b, 0x88, 0xa1, 0x31, 0x98,
+	0x3c, 0x87, 0x2a, 0x0e, 0x67, 0xd5, 0xd1, 0xea, 0xe1, 0xfa, 0x55, 0x50, 0x09, 0x14, 0xe7, 0x8c,
+	0x9e, 0xdf, 0xb8, 0xe3, 0x9c, 0x27, 0xce, 0x65, 0xa4, 0x82, 0x22, 0x1b, 0x44, 0xcd, 0x7c, 0x10,
+	0xd9, 0x5f, 0x41, 0x23, 0xbe, 0x48, 0xd2, 0x00, 0x43, 0xdc, 0x8e, 0xb9, 0x43, 0x5a, 0x50, 0x57,
+	0xcb, 0x34, 0x35, 0xd9, 0xc1, 0x93, 0x37, 0x2b, 0xa4, 0x0d, 0x8d, 0x78, 0xc3, 0xa6, 0x4e, 0xbe,
+	0x07, 0xfb, 0x25, 0xc7, 0x63, 0x1a, 0xa4, 0x09, 0x55, 0x54, 0x98, 0x55, 0x31, 0xaa, 0x58, 0x8b,
+	0x59, 0xb3, 0xff, 0x5c, 0x87, 0x4e, 0xee, 0x62, 0x44, 0xb8, 0xc6, 0x57, 0x93, 0xcf, 0x7a, 0x45,
+	0x31, 0x79, 0x08, 0xb5, 0x0b, 0xc7, 0x9b, 0xb1, 0x29, 0x3a, 0x57, 0x03, 0xa3, 0x49, 0x49, 0xc8,
+	0x4f, 0xa1, 0xe1, 0x06, 0x3e, 0x67, 0xb7, 0x3c, 0xb2, 0xf4, 0xfb, 0x12, 0x63, 0x02, 0x25, 0xbf,
+	0x84, 0x4e, 0x3c, 0xcb, 0x08, 0x93, 0xaa, 0x71, 0x9f, 0x6d, 0x1e, 0x4f, 0x5e, 0x26, 0x69, 0x41,
+	0xe5, 0x2b, 0xe5, 0x47, 0xf7, 0x27, 0xba, 0x82, 0x1d, 0x26, 0xe0, 0x7c, 0xea, 0xb3, 0x6a, 0x1b,
+	0x0e, 0x55, 0x34, 0x2c, 0x4d, 0x8b, 0x4f, 0xa0, 0xc3, 0x6e, 0x99, 0xbb, 0xe4, 0x5e, 0xe0, 0x4f,
+	0xbc, 0x39, 0x43, 0xcf, 0xd1, 0x69, 0x5e, 0x48, 0x1e, 0x41, 0x3d, 0xba, 0xf6, 0x16, 0x0b, 0x36,
+	0xb5, 0x9a, 0xc9, 0x21, 0xc7, 0x22, 0xf2, 0x3e, 0x80, 0x68, 0x0e, 0xc3, 0x30, 0x08, 0x23, 0x99,
+	0x00, 0x69, 0x46, 0x42, 0xba, 0x50, 0x19, 0x0d, 0xac, 0x16, 0x5e, 0x5f, 0x65, 0x34, 0x10, 0xc7,
+	0xcb, 0x99, 0x13, 0x0e, 0x82, 0x1b, 0x5f, 0x78, 0x95, 0xcc, 0x6a, 0x77, 0x1f, 0x6f, 0x0e, 0x4f,
+	0x0e, 0xc1, 0x88, 0x16, 0x8e, 0x6f, 0x75, 0xf0, 0x24, 0x0e, 0x8a, 0x76, 0xe3, 0x85, 0xe3, 0x53,
+	0x44, 0x90, 0x11, 0xec, 0x26, 0x3b, 0x19, 0x73, 0x87, 0x2f, 0x23, 0xcc, 0x74, 0xdd, 0xa3, 0xc7,
+	0x45, 0xa3, 0x61, 0x1e, 0x46, 0x8b, 0x76, 0x65, 0x05, 0x64, 0x77, 0xf3, 0x02, 0x62, 0x6e, 0x5c,
+	0x40, 0xf6, 0xb6, 0x29, 0x20, 0x64, 0xdb, 0x02, 0xb2, 0xbf, 0x6d, 0x01, 0x39, 0x58, 0x5b, 0x40,
+	0xec, 0x0b, 0x30, 0xc4, 0x51, 0x93, 0x03, 0xa8, 0x46, 0xdc, 0x09, 0x39, 0x46, 0xa8, 0x4e, 0x65,
+	0x87, 0x98, 0xa0, 0x33, 0x5f, 0x06, 0xa5, 0x4e, 0x45, 0x53, 0x14, 0x1c, 0x54, 0x9d, 0x5c, 0x39,
+	0x21, 0xe6, 0x75, 0x9d, 0xa6, 0x02, 0x62, 0x41, 0x9d, 0xf9, 0x53, 0xd4, 0x19, 0xa8, 0x8b, 0xbb,
+	0xf6, 0x3f, 0x2b, 0x60, 0xad, 0x4b, 0xb8, 0xb9, 0x94, 0xaf, 0x6d, 0x97, 0xf2, 0x9f, 0x40, 0x07,
+	0xb3, 0x26, 0x0d, 0x6e, 0x46, 0xfe, 0x94, 0xdd, 0xe2, 0x5a, 0xab, 0x34, 0x2f, 0x24, 0x3f, 0x81,
+	0xf7, 0x62, 0x8b, 0x49, 0x0e, 0xad, 0x23, 0xba, 0x5c, 0x49, 0x9e, 0xc1, 0x9e, 0x17, 0x09, 0xee,
+	0x96, 0xa5, 0x58, 0x06, 0x52, 0xac, 0x55, 0x85, 0x98, 0xc3, 0x8b, 0xc6, 0xd9, 0x81, 0x94, 0x45,
+	0x15, 0x2d, 0xca, 0x95, 0xe4, 0x25, 0xec, 0xc5, 0x93, 0x0f, 0x1c, 0xee, 0xa0, 0xca, 0xaa, 0xdd,
+	0x5b, 0x2a, 0x56, 0x8d, 0xec, 0x3f, 0xea, 0x31, 0xdb, 0x14, 0xd5, 0xf7, 0x7d, 0x00, 0xc7, 0xe5,
+	0x4b, 0x67, 0x36, 0x61, 0xb7, 0x5c, 0xa5, 0xdd, 0x8c, 0x44, 0xe8, 0x17, 0x4e, 0x18, 0xb1, 0x29,
+	0xea, 0x2b, 0x52, 0x9f, 0x4a, 0xc8, 0x0b, 0x68, 0x5e, 0x84, 0xce, 0xa5, 0x28, 0x12, 0x71, 0xda,
+	0xb5, 0x8a, 0xeb, 0x39, 0x55, 0x00, 0x9a, 0x42, 0x45, 0x09, 0x8e, 0x38, 0x5b, 0x24, 0x91, 0x48,
+	0x59, 0xb4, 0x9c, 0x71, 0xcb, 0xb8, 0xa3, 0x04, 0x8f, 0x57, 0xf1, 0xb4, 0x6c, 0x90, 0xb2, 0xe8,
+	0xad, 0x6e, 0x1e, 0xbd, 0xb5, 0x35, 0xd1, 0x5b, 0x1e, 0x63, 0xf5, 0x6d, 0x63, 0xac, 0xb1, 0x3e,
+	0xc6, 0xfe, 0xa1, 0x41, 0x3b, 0xcb, 0x75, 0xc8, 0xcf, 0xa1, 0xa5, 0xd8, 0x8e, 0xd8, 0xbb, 0x72,
+	0xf9, 0x3b, 0x08, 0x55, 0x16, 0x2d, 0x5e, 0x09, 0x11, 0x66, 0xdc, 0xfb, 0x5f, 0x09, 0x88, 0x23,
+	0xbf, 0x83, 0x07, 0xca, 0xbe, 0x78, 0x2b, 0xfa, 0x96, 0xb7, 0xb2, 0x66, 0x1c, 0xfb, 0xb1, 0xf2,
+	0x3c, 0xc1, 0x04, 0x92, 0x0a, 0xa5, 0xa5, 0x15, 0xca, 0xfe, 0x9b, 0x06, 0x8d, 0xd8, 0x5b, 0xc8,
+	0x08, 0xda, 0xb1, 0xbf, 0x64, 0xb8, 0xe8, 0x07, 0xeb, 0xbc, 0xab, 0x7f, 0x9a, 0x01, 0xd3, 0x9c,
+	0x29, 0xce, 0x95, 0xfa, 0x2f, 0xb6, 0xc9, 0x27, 0xd0, 0x5c, 0x38, 0xa1, 0x33, 0x67, 0x9c, 0x85,
+	0x6a, 0x87, 0xab, 0x67, 0x14, 0x03, 0x68, 0x8a, 0xb5, 0x3f, 0x84, 0x76, 0x76, 0x2a, 0xa4, 0x36,
+	0xec, 0x96, 0x9b, 0x3b, 0xa4, 0x03, 0xcd, 0xc4, 0xc2, 0xd4, 0xec, 0x3f, 0x54, 0x32, 0x7d, 0xf2,
+	0x39, 0x74, 0x92, 0x31, 0x32, 0xfb, 0xf9, 0x70, 0xed, 0x9c, 0xfd, 0xf3, 0x2c, 0x9c, 0xe6, 0xad,
+	0x45, 0x22, 0x7e, 0xe7, 0xcc, 0x96, 0x4c, 0xed, 0x49, 0x76, 0xc4, 0x46, 0x7d, 0x41, 0xf0, 0x74,
+	0xb9, 0x51, 0xd1, 0x4e, 0x99, 0xa5, 0xb1, 0x21, 0xb3, 0xb4, 0xbf, 0x80, 0x4e, 0x6e, 0x6e, 0x02,
+	0x50, 0x13, 0x95, 0xd1, 0x73, 0x25, 0x2b, 0x1c, 0x7c, 0xed, 0x3b, 0x73, 0xcf, 0x35, 0x35, 0x42,
+	0xa0, 0x2b, 0xf2, 0x9b, 0xe7, 0xcc, 0xbe, 0x1c, 0xf3, 0xd0, 0xf3, 0x2f, 0xcd, 0x0a, 0xd9, 0x83,
+	0x4e, 0x2c, 0x93, 0xec, 0x4f, 0x4f, 0x89, 0xa0, 0x61, 0xdb, 0x89, 0x8f, 0x4b, 0xde, 0x1b, 0x5f,
+	0x8d, 0x96, 0x5e, 0x8d, 0x7d, 0x0b, 0x90, 0x2e, 0x8a, 0x7c, 0x02, 0xf5, 0x2b, 0xe6, 0x4c, 0x59,
+	0x18, 0xdd, 0x99, 0xf4, 0xe3, 0x9c, 0x4c, 0x63, 0x34, 0xf9, 0x31, 0x18, 0x61, 0x70, 0x13, 0x07,
+	0xc0, 0x3d, 0x56, 0x08, 0xb5, 0x3f, 0x80, 0x4e, 0x4e, 0x2c, 0x8e, 0xd9, 0x65, 0xb3, 0x59, 0xec,
+	0xa6, 0xb2, 0x63, 0xff, 0x3d, 0xae, 0x52, 0x25, 0xde, 0x4f, 0xce, 0x32, 0x3c, 0x44, 0x05, 0x90,
+	0x5c, 0xf7, 0x93, 0xd2, 0x15, 0x14, 0x83, 0xa7, 0x68, 0x5c, 0x42, 0x30, 0x2b, 0xff, 0x3f, 0x82,
+	0xa9, 0xff, 0xaf, 0x04, 0xd3, 0x4a, 0x69, 0xa2, 0x2c, 0x75, 0x71, 0x57, 0x94, 0x5a, 0xd5, 0xa4,
+	0xcc, 0x89, 0x02, 0x59, 0xd8, 0x9a, 0x34, 0x2f, 0x24, 0x0f, 0xa0, 0x16, 0xf1, 0x69, 0xb0, 0xe4,
+	0xea, 0x45, 0xaf, 0x7a, 0xf6, 0xb7, 0x3a, 0x1c, 0x94, 0x9d, 0x8b, 0x30, 0x50, 0xdc, 0x5f, 0xc3,
+	0xf9, 0x54, 0x4f, 0xe4, 0xf0, 0x90, 0xb9, 0xc1, 0x3b, 0x16, 0x8a, 0x3b, 0x43, 0x1a, 0x2a, 0x5f,
+	0x07, 0x74, 0x45, 0x4e, 0x6c, 0x68, 0x33, 0xd1, 0x88, 0x29, 0x95, 0x0c, 0x93, 0x9c, 0x0c, 0x19,
+	0x2e, 0x77, 0xdc, 0xeb, 0x49, 0xe8, 0xb8, 0x32, 0x66, 0x9a, 0x34, 0x23, 0x21, 0x36, 0x40, 0x84,
+	0x49, 0x7b, 0x7c, 0x15, 0x70, 0xdc, 0x5b, 0x1b, 0x49, 0x59, 0x46, 0xba, 0xca, 0xb4, 0x6b, 0x65,
+	0x4c, 0xdb, 0x82, 0xba, 0x3a, 0x70, 0x45, 0xd3, 0xe3, 0x2e, 0x79, 0x05, 0x4d, 0x5c, 0x13, 0xe6,
+	0x89, 0x06, 0xe6, 0x89, 0xfe, 0x26, 0xce, 0xd3, 0x1f, 0xc6, 0x56, 0x34, 0x1d, 0x40, 0xf0, 0x93,
+	0x0b, 0x79, 0x6b, 0x69, 0xb5, 0x41, 0x6e, 0xdf, 0xa6, 0xab, 0x0a, 0xfc, 0x7f, 0xca, 0xd4, 0x2b,
+	0xc0, 0x7a, 0x95, 0x15, 0xd9, 0xcf, 0xa0, 0x99, 0xcc, 0x23, 0x72, 0xde, 0xf1, 0x78, 0x3c, 0xa4,
+	0x93, 0xd1, 0xeb, 0x33, 0x73, 0x87, 0x98, 0xd0, 0x7e, 0x33, 0xa4, 0xa3, 0xd3, 0xd1, 0xc9, 0x31,
+	0x4a, 0x34, 0xfb, 0x5b, 0x0d, 0xcc, 0xa2, 0x3b, 0x15, 0x0e, 0x59, 0x2b, 0x39, 0xe4, 0xfc, 0x45,
+	0x55, 0x4a, 0x2e, 0x2a, 0x7f, 0x11, 0xfa, 0xba, 0x8b, 0xc8, 0xd3, 0x3e, 0xa3, 0x8c, 0xf6, 0x95,
+	0x1e, 0x50, 0x75, 0xcd, 0x01, 0xd9, 0xff, 0xaa, 0xa9, 0x0d, 0x8d, 0x97, 0x1e, 0x67, 0xca, 0x3b,
+	0x8f, 0xe5, 0xaf, 0x9d, 0xec, 0xc9, 0x6c, 0xd1, 0x5a, 0x7d, 0x78, 0x24, 0xbf, 0x7c, 0x2a, 0xd6,
+	0xb3, 0x36, 0xdf, 0xd1, 0x38, 0x4f, 0xc3, 0xce, 0x28, 0x86, 0x9d, 0x58, 0x7c, 0x74, 0x8a, 0x5d,
+	0xf9, 0xb1, 0x54, 0xc5, 0xc3, 0x5d, 0x91, 0x6f, 0x18, 0x0e, 0xc2, 0xf1, 0x96, 0xae, 0xcb, 0xa2,
+	0x88, 0x3a, 0x5c, 0xfe, 0x83, 0x54, 0x68, 0x56, 0x24, 0x10, 0xcc, 0x7f, 0xe7, 0x85, 0x81, 0x8f,
+	0xff, 0x2b, 0x0d, 0xf9, 0x35, 0x9a, 0x11, 0x25, 0xa4, 0xa2, 0xa9, 0xaa, 0x89, 0x20, 0x1a, 0x3d,
+	0x68, 0x2d, 0xc2, 0xe0, 0x2b, 0xe6, 0x72, 0xfc, 0xfb, 0x00, 0x69, 0x95, 0x11, 0x89, 0xc7, 0x0a,
+	0xf7, 0xe6, 0x2c, 0xe2, 0xce, 0x7c, 0xa1, 0xde, 0xae, 0xa9, 0x40, 0x78, 0x07, 0xee, 0x68, 0x2c,
+	0xf3, 0x97, 0xdc, 0x6a, 0x1b, 0xb7, 0xba, 0xaa, 0x28, 0x23, 0x9f, 0x9d, 0xcd, 0xc9, 0x67, 0x77,
+	0xe3, 0xa7, 0xe3, 0xee, 0x36, 0x4f, 0x47, 0x73, 0xdb, 0xa7, 0xe3, 0xde, 0xb6, 0xb4, 0x96, 0xac,
+	0xff, 0x7b, 0xb4, 0xa0, 0xee, 0x5e, 0x2d, 0xfd, 0x6b, 0x36, 0xb5, 0xf6, 0x65, 0xa5, 0x50, 0x5d,
+	0x71, 0xee, 0xd8, 0x1c, 0x7b, 0xdf, 0x30, 0xeb, 0x40, 0x3e, 0x12, 0x13, 0x81, 0xfd, 0x17, 0x1d,
+	0x76, 0x0b, 0x01, 0x83, 0xa4, 0x2d, 0x16, 0xdd, 0xcd, 0x87, 0x85, 0x4d, 0x8a, 0xc5, 0xa2, 0xa4,
+	0x9e, 0x42, 0xf2, 0x02, 0xd5, 0xfb, 0x2f, 0x27, 0x14, 0x9b, 0x8b, 0x05, 0x59, 0xbf, 0x96, 0xaf,
+	0xbf, 0x32, 0xd5, 0xda, 0xf0, 0x78, 0x0e, 0xfb, 0xb2, 0x95, 0x3c, 0xbc, 0x68, 0x70, 0x23, 0xdf,
+	0x21, 0x55, 0x5a, 0xa6, 0xda, 0xbc, 0x66, 0xc4, 0x65, 0xb7, 0x9e, 0x2f, 0xbb, 0x47, 0x70, 0x10,
+	0x2f, 0x30, 0xe7, 0xa9, 0x0d, 0x5c, 0x7c, 0xa9, 0x0e, 0x6d, 0x64, 0x3f, 0xbf, 0xcc, 0x26, 0x2e,
+	0xb3, 0x54, 0x47, 0x3e, 0x82, 0x1a, 0x4b, 0x7f, 0x7f, 0x5a, 0x47, 0xef, 0xad, 0xfc, 0xae, 0x08,
+	0x2d, 0x55, 0x20, 0xfb, 0xaf, 0x1a, 0x54, 0x51, 0x42, 0x3e, 0x06, 0x83, 0xa7, 0xbc, 0xf7, 0x71,
+	0xa9, 0x59, 0xa6, 0x80, 0x21, 0x38, 0xfe, 0xb5, 0x44, 0x52, 0x5b, 0x49, 0x7f, 0x2d, 0x45, 0x5f,
+	0x14, 0x91, 0x99, 0xe7, 0xb3, 0xb3, 0xe5, 0xfc, 0xad, 0xa2, 0xf0, 0x55, 0x9a, 0x91, 0x64, 0xeb,
+	0xab, 0x2c, 0xe3, 0x71, 0xd7, 0x3e, 0xca, 0x56, 0xb0, 0x5d, 0x68, 0x9d, 0x1f, 0xd3, 0xf1, 0xf0,
+	0xcb, 0x21, 0xa5, 0xaf, 0xa9, 0xb9, 0x43, 0x0e, 0xc0, 0x7c, 0x73, 0xfc, 0x6a, 0x34, 0xc0, 0x0a,
+	0xa6, 0xa4, 0x9a, 0xfd, 0x7b, 0x0d, 0xba, 0x09, 0xe7, 0x7b, 0x83, 0x6c, 0x1b, 0x3f, 0x39, 0x54,
+	0x47, 0x15, 0xb1, 0x54, 0x40, 0x5e, 0xc0, 0x83, 0x84, 0xb2, 0x7b, 0xdf, 0xb0, 0x69, 0x62, 0xa7,
+	0x36, 0xb2, 0x46, 0xab, 0x9e, 0xdc, 0x52, 0x23, 0xdf, 0xd4, 0x4d, 0x9a, 0x91, 0x3c, 0xfd, 0x0c,
+	0x76, 0x0b, 0x1f, 0x58, 0x62, 0x0b, 0x67, 0xaf, 0x27, 0xc3, 0xdf, 0x0e, 0x4f, 0x7e, 0x33, 0x19,
+	0x0e, 0xcc, 0x1d, 0x41, 0xd8, 0xcf, 0x45, 0x59, 0x1e, 0x98, 0x9a, 0x68, 0x9f, 0x1e, 0x8f, 0x5e,
+	0x0d, 0x07, 0x66, 0x45, 0x90, 0xf7, 0xf1, 0xaf, 0x47, 0xe7, 0xe7, 0xc3, 0x81, 0xa9, 0x7f, 0xfa,
+	0x43, 0xf1, 0xe0, 0x9b, 0xf7, 0xf9, 0x55, 0xb0, 0xbc, 0xbc, 0xe2, 0x37, 0x41, 0x78, 0x1d, 0xc9,
+	0x4b, 0xf9, 0x53, 0xa5, 0xfb, 0x19, 0x5e, 0x4e, 0x9c, 0x7f, 0xde, 0xd6, 0x30, 0x92, 0x3e, 0xfe,
+	0xef, 0x00, 0x42, 0x1b, 0xab, 0xc3, 0xc7, 0x1a, 0x00, 0x00,
 }
@@ -0,0 +1,235 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// Severity tells how serious an issue is. Rules with severity off are not run.
+type Severity string
+
+const (
+	Error   Severity = "error"
+	Warning Severity = "warning"
+	Info    Severity = "info"
+	Off     Severity = "off"
+)
+
+// Issue is a problem found by a lint rule.
+type Issue struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Message  string   `json:"message"`
+}
+
+// RuleConfig configures a lint rule. Max is the maximum number of words in a step for the long-step rule, and Style is
+// the heading style (hash, underline or consistent) for the heading-style rule.
+type RuleConfig struct {
+	Severity Severity `json:"severity,omitempty"`
+	Max      int      `json:"max,omitempty"`
+	Style    string   `json:"style,omitempty"`
+}
+
+// Config holds the configuration of the lint rules, read from the Lint section of the project manifest. Rules which are
+// not configured use their default configuration.
+//
+//	"Lint": {
+//	  "rules": {
+//	    "long-step": {"severity": "error", "max": 10},
+//	    "scenario-tags": {"severity": "warning"}
+//	  }
+//	}
+type Config struct {
+	Rules map[string]RuleConfig `json:"rules"`
+}
+
+// LoadConfig reads the lint configuration of the project.
+func LoadConfig() (*Config, error) {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	if len(m.Lint) > 0 {
+		if err := json.Unmarshal(m.Lint, config); err != nil {
+			return nil, fmt.Errorf("Failed to read lint configuration. %s", err.Error())
+		}
+	}
+	return config, nil
+}
+
+// Linter checks specs and concepts with the rules which are not turned off.
+type Linter struct {
+	rules map[string]RuleConfig
+}
+
+// NewLinter creates a Linter with the given configuration applied over the default configuration of the rules.
+func NewLinter(config *Config) (*Linter, error) {
+	rules := make(map[string]RuleConfig)
+	for name, c := range defaultRules {
+		rules[name] = c
+	}
+	for name, c := range config.Rules {
+		d, ok := defaultRules[name]
+		if !ok {
+			return nil, fmt.Errorf("Unknown lint rule %s", name)
+		}
+		if c.Severity == "" {
+			c.Severity = d.Severity
+		}
+		if c.Max == 0 {
+			c.Max = d.Max
+		}
+		if c.Style == "" {
+			c.Style = d.Style
+		}
+		switch c.Severity {
+		case Error, Warning, Info, Off:
+		default:
+			return nil, fmt.Errorf("Invalid severity %s for lint rule %s", c.Severity, name)
+		}
+		if name == headingStyle && c.Style != hashStyle && c.Style != underlineStyle && c.Style != consistentStyle {
+			return nil, fmt.Errorf("Invalid style %s for lint rule %s. Expected %s, %s or %s", c.Style, name, hashStyle, underlineStyle, consistentStyle)
+		}
+		rules[name] = c
+	}
+	return &Linter{rules: rules}, nil
+}
+
+// Spec checks the spec. The content is the text the spec is parsed from.
+func (l *Linter) Spec(spec *gauge.Specification, content string) []*Issue {
+	lines := strings.Split(content, "\n")
+	var issues []*Issue
+	for name, newRule := range specRules {
+		if l.rules[name].Severity == Off {
+			continue
+		}
+		r := newRule(&base{name: name, config: l.rules[name], file: spec.FileName, lines: lines})
+		spec.Traverse(r, &gauge.ItemQueue{Items: spec.AllItems()})
+		issues = append(issues, r.issues()...)
+	}
+	sortIssues(issues)
+	return issues
+}
+
+// Concepts checks the concepts of the dictionary.
+func (l *Linter) Concepts(dictionary *gauge.ConceptDictionary) []*Issue {
+	var issues []*Issue
+	for name, check := range conceptRules {
+		if l.rules[name].Severity == Off {
+			continue
+		}
+		for _, concept := range dictionary.ConceptsMap {
+			b := &base{name: name, config: l.rules[name], file: concept.FileName}
+			check(b, concept)
+			issues = append(issues, b.found...)
+		}
+	}
+	sortIssues(issues)
+	return issues
+}
+
+// Lint checks the specs in the given dirs and the concepts of the project, prints the issues and exits with a non zero
+// exit code if there is an issue with error severity.
+func Lint(specDirs []string, machineReadable bool) {
+	config, err := LoadConfig()
+	if err != nil {
+		logger.Fatalf(true, "Unable to lint : %s", err.Error())
+	}
+	linter, err := NewLinter(config)
+	if err != nil {
+		logger.Fatalf(true, "Unable to lint : %s", err.Error())
+	}
+	dictionary, res, err := parser.ParseConcepts()
+	if err != nil {
+		logger.Fatalf(true, "Unable to lint : %s", err.Error())
+	}
+	specs, failed := parser.ParseSpecs(specDirs, dictionary, gauge.NewBuildErrors())
+	if !res.Ok || failed {
+		os.Exit(1)
+	}
+	issues := linter.Concepts(dictionary)
+	for _, spec := range specs {
+		content, err := common.ReadFileContents(spec.FileName)
+		if err != nil {
+			logger.Fatalf(true, "Unable to lint : %s", err.Error())
+		}
+		issues = append(issues, linter.Spec(spec, content)...)
+	}
+	sortIssues(issues)
+	printIssues(issues, machineReadable)
+	for _, issue := range issues {
+		if issue.Severity == Error {
+			os.Exit(1)
+		}
+	}
+}
+
+func sortIssues(issues []*Issue) {
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Rule < issues[j].Rule
+	})
+}
+
+func printIssues(issues []*Issue, machineReadable bool) {
+	if machineReadable {
+		if issues == nil {
+			issues = []*Issue{}
+		}
+		b, err := json.Marshal(issues)
+		if err != nil {
+			logger.Fatalf(true, "Failed to convert lint issues to JSON. Reason: %s", err.Error())
+		}
+		fmt.Println(string(b))
+		return
+	}
+	if len(issues) == 0 {
+		logger.Infof(true, "No lint issues found.")
+		return
+	}
+	for _, issue := range issues {
+		text := fmt.Sprintf("%s:%d %s: %s [%s]", util.RelPathToProjectRoot(issue.File), issue.Line, issue.Severity, issue.Message, issue.Rule)
+		switch issue.Severity {
+		case Error:
+			logger.Error(true, text)
+		case Warning:
+			logger.Warning(true, text)
+		default:
+			logger.Info(true, text)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package lint
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func lintSpec(c *C, config *Config, content string) []*Issue {
+	linter, err := NewLinter(config)
+	c.Assert(err, IsNil)
+	spec, res, err := new(parser.SpecParser).Parse(content, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.ParseErrors, IsNil)
+	return linter.Spec(spec, content)
+}
+
+func (s *MySuite) TestLintSpecWithDefaultRules(c *C) {
+	issues := lintSpec(c, &Config{}, `# Spec
+
+|user |role |
+|-----|-----|
+|john |admin|
+
+## Login as admin
+* login as <user>
+
+## login, as admin
+* login as "admin"
+
+Other
+-----
+* a step with far too many words in it for anyone to read it in one go at all
+`)
+
+	c.Assert(issues, DeepEquals, []*Issue{
+		{Rule: unusedDataTableColumn, Severity: Warning, File: "foo.spec", Line: 3, Message: "Data table column 'role' is not used by any step"},
+		{Rule: duplicateScenarioHeading, Severity: Error, File: "foo.spec", Line: 10, Message: "Scenario heading 'login, as admin' is a duplicate of 'Login as admin'"},
+		{Rule: headingStyle, Severity: Warning, File: "foo.spec", Line: 13, Message: "Heading 'Other' uses underline style, expected hash style"},
+		{Rule: longStep, Severity: Warning, File: "foo.spec", Line: 15, Message: "Step has 19 words, more than 15"},
+	})
+}
+
+func (s *MySuite) TestLintSpecWithConfiguredRules(c *C) {
+	config := &Config{Rules: map[string]RuleConfig{
+		longStep:              {Max: 3},
+		scenarioTags:          {Severity: Info},
+		headingStyle:          {Severity: Error, Style: underlineStyle},
+		unusedDataTableColumn: {Severity: Off},
+	}}
+
+	issues := lintSpec(c, config, `Spec
+====
+
+## Login
+Tags: smoke
+
+* login as "john" now
+
+## Logout
+* logout
+`)
+
+	c.Assert(issues, DeepEquals, []*Issue{
+		{Rule: headingStyle, Severity: Error, File: "foo.spec", Line: 4, Message: "Heading 'Login' uses hash style, expected underline style"},
+		{Rule: longStep, Severity: Warning, File: "foo.spec", Line: 7, Message: "Step has 4 words, more than 3"},
+		{Rule: headingStyle, Severity: Error, File: "foo.spec", Line: 9, Message: "Heading 'Logout' uses hash style, expected underline style"},
+		{Rule: scenarioTags, Severity: Info, File: "foo.spec", Line: 9, Message: "Scenario 'Logout' has no tags"},
+	})
+}
+
+func (s *MySuite) TestLintScenarioTagsWithSpecTags(c *C) {
+	config := &Config{Rules: map[string]RuleConfig{scenarioTags: {Severity: Warning}}}
+
+	issues := lintSpec(c, config, "# Spec\nTags: smoke\n\n## Login\n* login\n")
+
+	c.Assert(issues, IsNil)
+}
+
+func (s *MySuite) TestLintConcepts(c *C) {
+	linter, err := NewLinter(&Config{})
+	c.Assert(err, IsNil)
+	dictionary := gauge.NewConceptDictionary()
+	concepts, res := new(parser.ConceptParser).Parse("# login as <user> with <password>\n* enter <user>\n|field|\n|-----|\n|<password>|\n\n# logout <user>\n* logout\n", "foo.cpt")
+	c.Assert(res.ParseErrors, IsNil)
+	_, err = parser.AddConcept(concepts, "foo.cpt", dictionary)
+	c.Assert(err, IsNil)
+
+	issues := linter.Concepts(dictionary)
+
+	c.Assert(issues, DeepEquals, []*Issue{
+		{Rule: unusedConceptParam, Severity: Warning, File: "foo.cpt", Line: 7, Message: "Concept parameter <user> is not used in the concept"},
+	})
+}
+
+func (s *MySuite) TestNewLinterWithInvalidConfig(c *C) {
+	_, err := NewLinter(&Config{Rules: map[string]RuleConfig{"no-such-rule": {}}})
+	c.Assert(err, ErrorMatches, "Unknown lint rule no-such-rule")
+
+	_, err = NewLinter(&Config{Rules: map[string]RuleConfig{longStep: {Severity: "fatal"}}})
+	c.Assert(err, ErrorMatches, "Invalid severity fatal for lint rule long-step")
+
+	_, err = NewLinter(&Config{Rules: map[string]RuleConfig{headingStyle: {Style: "bold"}}})
+	c.Assert(err, ErrorMatches, "Invalid style bold for lint rule heading-style. Expected hash, underline or consistent")
+}
@@ -0,0 +1,212 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+const (
+	duplicateScenarioHeading = "duplicate-scenario-heading"
+	longStep                 = "long-step"
+	scenarioTags             = "scenario-tags"
+	unusedDataTableColumn    = "unused-data-table-column"
+	unusedConceptParam       = "unused-concept-param"
+	headingStyle             = "heading-style"
+)
+
+const (
+	hashStyle       = "hash"
+	underlineStyle  = "underline"
+	consistentStyle = "consistent"
+)
+
+// defaultRules holds the rules which can be configured, with their default configuration.
+var defaultRules = map[string]RuleConfig{
+	duplicateScenarioHeading: {Severity: Error},
+	longStep:                 {Severity: Warning, Max: 15},
+	scenarioTags:             {Severity: Off},
+	unusedDataTableColumn:    {Severity: Warning},
+	unusedConceptParam:       {Severity: Warning},
+	headingStyle:             {Severity: Warning, Style: consistentStyle},
+}
+
+// specRules creates the rules which check a spec. Each rule is an ItemProcessor which is given the items of one spec.
+var specRules = map[string]func(*base) rule{
+	duplicateScenarioHeading: func(b *base) rule { return &duplicateScenarioHeadingRule{base: b, seen: make(map[string]string)} },
+	longStep:                 func(b *base) rule { return &longStepRule{base: b} },
+	scenarioTags:             func(b *base) rule { return &scenarioTagsRule{base: b} },
+	unusedDataTableColumn:    func(b *base) rule { return &unusedDataTableColumnRule{base: b} },
+	headingStyle:             func(b *base) rule { return &headingStyleRule{base: b} },
+}
+
+// conceptRules check a concept definition.
+var conceptRules = map[string]func(*base, *gauge.Concept){
+	unusedConceptParam: checkUnusedConceptParams,
+}
+
+type rule interface {
+	gauge.ItemProcessor
+	issues() []*Issue
+}
+
+// base is embedded by the rules. It ignores the items a rule is not interested in and collects the issues found.
+type base struct {
+	name   string
+	config RuleConfig
+	file   string
+	lines  []string
+	found  []*Issue
+}
+
+func (b *base) Specification(*gauge.Specification) {}
+func (b *base) Heading(*gauge.Heading)             {}
+func (b *base) Tags(*gauge.Tags)                   {}
+func (b *base) Table(*gauge.Table)                 {}
+func (b *base) DataTable(*gauge.DataTable)         {}
+func (b *base) Scenario(*gauge.Scenario)           {}
+func (b *base) Step(*gauge.Step)                   {}
+func (b *base) TearDown(*gauge.TearDown)           {}
+func (b *base) Comment(*gauge.Comment)             {}
+
+func (b *base) issues() []*Issue {
+	return b.found
+}
+
+func (b *base) report(line int, format string, args ...interface{}) {
+	b.found = append(b.found, &Issue{Rule: b.name, Severity: b.config.Severity, File: b.file, Line: line, Message: fmt.Sprintf(format, args...)})
+}
+
+// duplicateScenarioHeadingRule reports scenario headings of a spec which differ only in case, spaces or punctuation.
+// Headings which differ only in case are already parse errors.
+type duplicateScenarioHeadingRule struct {
+	*base
+	seen map[string]string
+}
+
+func (r *duplicateScenarioHeadingRule) Scenario(scenario *gauge.Scenario) {
+	heading := strings.Join(strings.FieldsFunc(strings.ToLower(scenario.Heading.Value), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	}), " ")
+	if other, ok := r.seen[heading]; ok {
+		r.report(scenario.Heading.LineNo, "Scenario heading '%s' is a duplicate of '%s'", scenario.Heading.Value, other)
+		return
+	}
+	r.seen[heading] = scenario.Heading.Value
+}
+
+type longStepRule struct {
+	*base
+}
+
+func (r *longStepRule) Step(step *gauge.Step) {
+	if words := len(strings.Fields(step.Value)); words > r.config.Max {
+		r.report(step.LineNo, "Step has %d words, more than %d", words, r.config.Max)
+	}
+}
+
+type scenarioTagsRule struct {
+	*base
+	specTagged bool
+}
+
+func (r *scenarioTagsRule) Specification(spec *gauge.Specification) {
+	r.specTagged = spec.Tags != nil && len(spec.Tags.Values()) > 0
+}
+
+func (r *scenarioTagsRule) Scenario(scenario *gauge.Scenario) {
+	if !r.specTagged && (scenario.Tags == nil || len(scenario.Tags.Values()) == 0) {
+		r.report(scenario.Heading.LineNo, "Scenario '%s' has no tags", scenario.Heading.Value)
+	}
+}
+
+// unusedDataTableColumnRule reports the columns of the spec data table which no step of the spec refers to.
+type unusedDataTableColumnRule struct {
+	*base
+	dataTable *gauge.DataTable
+	steps     []*gauge.Step
+}
+
+func (r *unusedDataTableColumnRule) DataTable(dataTable *gauge.DataTable) {
+	r.dataTable = dataTable
+}
+
+func (r *unusedDataTableColumnRule) Step(step *gauge.Step) {
+	r.steps = append(r.steps, step)
+}
+
+func (r *unusedDataTableColumnRule) issues() []*Issue {
+	if r.dataTable == nil || !r.dataTable.IsInitialized() {
+		return nil
+	}
+	line := r.dataTable.LineNo
+	if line == 0 {
+		line = r.dataTable.Table.LineNo
+	}
+	for _, header := range r.dataTable.Table.Headers {
+		if !usedByAny(r.steps, header) {
+			r.report(line, "Data table column '%s' is not used by any step", header)
+		}
+	}
+	return r.found
+}
+
+type headingStyleRule struct {
+	*base
+	style string
+}
+
+func (r *headingStyleRule) Heading(heading *gauge.Heading) {
+	if heading == nil || heading.LineNo < 1 || heading.LineNo > len(r.lines) {
+		return
+	}
+	style := underlineStyle
+	if strings.HasPrefix(strings.TrimSpace(r.lines[heading.LineNo-1]), "#") {
+		style = hashStyle
+	}
+	expected := r.config.Style
+	if expected == consistentStyle {
+		if r.style == "" {
+			r.style = style
+		}
+		expected = r.style
+	}
+	if style != expected {
+		r.report(heading.LineNo, "Heading '%s' uses %s style, expected %s style", heading.Value, style, expected)
+	}
+}
+
+func checkUnusedConceptParams(b *base, concept *gauge.Concept) {
+	for _, param := range concept.ConceptStep.Args {
+		if !usedByAny(concept.ConceptStep.ConceptSteps, param.Value) {
+			b.report(concept.ConceptStep.LineNo, "Concept parameter <%s> is not used in the concept", param.Value)
+		}
+	}
+}
+
+func usedByAny(steps []*gauge.Step, param string) bool {
+	for _, step := range steps {
+		if step.UsesDynamicArgs(param) {
+			return true
+		}
+	}
+	return false
+}
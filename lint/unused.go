@@ -0,0 +1,189 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/api/infoGatherer"
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+)
+
+// UnusedConcept is a concept which is not used by any spec, directly or through other concepts.
+type UnusedConcept struct {
+	Concept string   `json:"concept"`
+	File    string   `json:"file"`
+	Line    int      `json:"line"`
+	UsedBy  []string `json:"usedBy,omitempty"`
+}
+
+// UnusedResult holds the concepts and step implementations which can be deleted.
+type UnusedResult struct {
+	Concepts            []*UnusedConcept `json:"concepts"`
+	OnlyUsedByUnused    []*UnusedConcept `json:"conceptsOnlyUsedByUnusedConcepts"`
+	StepImplementations []string         `json:"stepImplementations"`
+}
+
+// IsEmpty tells if nothing unused is found.
+func (r *UnusedResult) IsEmpty() bool {
+	return len(r.Concepts) == 0 && len(r.OnlyUsedByUnused) == 0 && len(r.StepImplementations) == 0
+}
+
+// Unused finds the concepts and step implementations of the project which are not used by any spec, and prints them.
+// The step implementations are fetched from the runner.
+func Unused(startChan *runner.StartChannels, specDirs []string, machineReadable bool) {
+	sig := &infoGatherer.SpecInfoGatherer{SpecDirs: specDirs}
+	sig.Init()
+	var r runner.Runner
+	select {
+	case r = <-startChan.RunnerChan:
+	case err := <-startChan.ErrorChan:
+		logger.Fatalf(true, "Unable to find unused steps: Unable to connect to runner. %s", err.Error())
+	}
+	implemented, err := implementedSteps(r)
+	r.Kill()
+	if err != nil {
+		logger.Fatalf(true, "Unable to find unused steps: %s", err.Error())
+	}
+	printUnused(findUnused(sig.AllSteps(false), sig.Concepts(), implemented), machineReadable)
+}
+
+func implementedSteps(r runner.Runner) ([]string, error) {
+	m := &gm.Message{MessageType: gm.Message_StepNamesRequest, StepNamesRequest: &gm.StepNamesRequest{}}
+	res, err := r.ExecuteMessageWithTimeout(m)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get steps from runner. %s", err.Error())
+	}
+	return res.GetStepNamesResponse().GetSteps(), nil
+}
+
+// findUnused gives the concepts and step implementations which are not used. A concept is used if a spec uses it, or a
+// used concept uses it. A step implementation is used if any spec or concept uses it.
+func findUnused(steps []*gauge.Step, concepts []*gm.ConceptInfo, implemented []string) *UnusedResult {
+	usedBy := make(map[string]map[string]bool)
+	for _, step := range steps {
+		by := ""
+		if step.InConcept() {
+			by = step.Parent.Value
+		}
+		if usedBy[step.Value] == nil {
+			usedBy[step.Value] = make(map[string]bool)
+		}
+		usedBy[step.Value][by] = true
+	}
+	used := make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+		for _, concept := range concepts {
+			value := concept.GetStepValue().GetStepValue()
+			if used[value] {
+				continue
+			}
+			for by := range usedBy[value] {
+				if by == "" || used[by] {
+					used[value] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	result := &UnusedResult{Concepts: []*UnusedConcept{}, OnlyUsedByUnused: []*UnusedConcept{}, StepImplementations: []string{}}
+	headings := make(map[string]string)
+	for _, concept := range concepts {
+		headings[concept.GetStepValue().GetStepValue()] = concept.GetStepValue().GetParameterizedStepValue()
+	}
+	for _, concept := range concepts {
+		value := concept.GetStepValue().GetStepValue()
+		if used[value] {
+			continue
+		}
+		unused := &UnusedConcept{Concept: concept.GetStepValue().GetParameterizedStepValue(), File: util.RelPathToProjectRoot(concept.GetFilepath()), Line: int(concept.GetLineNumber())}
+		if len(usedBy[value]) == 0 {
+			result.Concepts = append(result.Concepts, unused)
+			continue
+		}
+		for by := range usedBy[value] {
+			unused.UsedBy = append(unused.UsedBy, headings[by])
+		}
+		sort.Strings(unused.UsedBy)
+		result.OnlyUsedByUnused = append(result.OnlyUsedByUnused, unused)
+	}
+	sortConcepts(result.Concepts)
+	sortConcepts(result.OnlyUsedByUnused)
+
+	for _, stepText := range implemented {
+		stepValue, err := parser.ExtractStepValueAndParams(stepText, false)
+		if err != nil || len(usedBy[stepValue.StepValue]) > 0 {
+			continue
+		}
+		result.StepImplementations = append(result.StepImplementations, stepText)
+	}
+	sort.Strings(result.StepImplementations)
+	return result
+}
+
+func sortConcepts(concepts []*UnusedConcept) {
+	sort.Slice(concepts, func(i, j int) bool {
+		if concepts[i].File != concepts[j].File {
+			return concepts[i].File < concepts[j].File
+		}
+		return concepts[i].Line < concepts[j].Line
+	})
+}
+
+func printUnused(result *UnusedResult, machineReadable bool) {
+	if machineReadable {
+		b, err := json.Marshal(result)
+		if err != nil {
+			logger.Fatalf(true, "Failed to convert unused steps to JSON. Reason: %s", err.Error())
+		}
+		fmt.Println(string(b))
+		return
+	}
+	if result.IsEmpty() {
+		logger.Infof(true, "No unused concepts or step implementations found.")
+		return
+	}
+	if len(result.Concepts) > 0 {
+		logger.Infof(true, "Unused concepts:")
+		for _, c := range result.Concepts {
+			logger.Info(true, fmt.Sprintf("  %s:%d %s", c.File, c.Line, c.Concept))
+		}
+	}
+	if len(result.OnlyUsedByUnused) > 0 {
+		logger.Infof(true, "Concepts used only by unused concepts:")
+		for _, c := range result.OnlyUsedByUnused {
+			logger.Info(true, fmt.Sprintf("  %s:%d %s (used by: %s)", c.File, c.Line, c.Concept, strings.Join(c.UsedBy, ", ")))
+		}
+	}
+	if len(result.StepImplementations) > 0 {
+		logger.Infof(true, "Unused step implementations:")
+		for _, s := range result.StepImplementations {
+			logger.Info(true, "  "+s)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package lint
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+func concept(value, heading, file string, line int, steps ...*gauge.Step) (*gauge.Step, *gm.ConceptInfo) {
+	conceptStep := &gauge.Step{Value: value, IsConcept: true, ConceptSteps: steps}
+	for _, s := range steps {
+		s.Parent = conceptStep
+	}
+	return conceptStep, &gm.ConceptInfo{StepValue: &gm.ProtoStepValue{StepValue: value, ParameterizedStepValue: heading}, Filepath: file, LineNumber: int32(line)}
+}
+
+func (s *MySuite) TestFindUnused(c *C) {
+	login, loginInfo := concept("login as {}", "login as <user>", "login.cpt", 1, &gauge.Step{Value: "enter user {}"}, &gauge.Step{Value: "submit"})
+	setup, setupInfo := concept("setup", "setup", "login.cpt", 5, &gauge.Step{Value: "login as {}", IsConcept: true}, &gauge.Step{Value: "open browser"})
+	teardown, teardownInfo := concept("teardown", "teardown", "admin.cpt", 1, &gauge.Step{Value: "close browser"})
+	steps := []*gauge.Step{{Value: "submit"}, {Value: "logout"}}
+	for _, concept := range []*gauge.Step{login, setup, teardown} {
+		steps = append(steps, concept.ConceptSteps...)
+	}
+
+	result := findUnused(steps, []*gm.ConceptInfo{loginInfo, setupInfo, teardownInfo},
+		[]string{"enter user <name>", "submit", "open browser", "close browser", "delete user <name>"})
+
+	c.Assert(result.Concepts, DeepEquals, []*UnusedConcept{
+		{Concept: "teardown", File: "admin.cpt", Line: 1},
+		{Concept: "setup", File: "login.cpt", Line: 5},
+	})
+	c.Assert(result.OnlyUsedByUnused, DeepEquals, []*UnusedConcept{{Concept: "login as <user>", File: "login.cpt", Line: 1, UsedBy: []string{"setup"}}})
+	c.Assert(result.StepImplementations, DeepEquals, []string{"delete user <name>"})
+}
+
+func (s *MySuite) TestFindUnusedWithConceptUsedBySpec(c *C) {
+	login, loginInfo := concept("login as {}", "login as <user>", "login.cpt", 1, &gauge.Step{Value: "enter user {}"})
+	steps := append([]*gauge.Step{{Value: "login as {}", IsConcept: true}}, login.ConceptSteps...)
+
+	result := findUnused(steps, []*gm.ConceptInfo{loginInfo}, []string{"enter user <name>"})
+
+	c.Assert(result.IsEmpty(), Equals, true)
+}
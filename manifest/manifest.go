@@ -32,6 +32,8 @@ import (
 type Manifest struct {
 	Language string
 	Plugins  []string
+	Lint     json.RawMessage `json:",omitempty"`
+	Format   json.RawMessage `json:",omitempty"`
 }
 
 func ProjectManifest() (*Manifest, error) {
@@ -15,7 +15,7 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		return token.Kind == gauge.SpecKind
 	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
 		if spec.Heading != nil {
-			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{spec.FileName, token.LineNo, "Multiple spec headings found in same file", token.LineText}}}
+			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{FileName: spec.FileName, LineNo: token.LineNo, Message: "Multiple spec headings found in same file", LineText: token.LineText}}}
 		}
 
 		spec.AddHeading(&gauge.Heading{LineNo: token.LineNo, Value: token.Value})
@@ -27,11 +27,11 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		return token.Kind == gauge.ScenarioKind
 	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
 		if spec.Heading == nil {
-			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{spec.FileName, token.LineNo, "Scenario should be defined after the spec heading", token.LineText}}}
+			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{FileName: spec.FileName, LineNo: token.LineNo, Message: "Scenario should be defined after the spec heading", LineText: token.LineText, Code: SpecHeadingNotFound}}}
 		}
 		for _, scenario := range spec.Scenarios {
 			if strings.ToLower(scenario.Heading.Value) == strings.ToLower(token.Value) {
-				return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{spec.FileName, token.LineNo, "Duplicate scenario definition '" + scenario.Heading.Value + "' found in the same specification", token.LineText}}}
+				return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{FileName: spec.FileName, LineNo: token.LineNo, Message: "Duplicate scenario definition '" + scenario.Heading.Value + "' found in the same specification", LineText: token.LineText, Code: DuplicateScenario}}}
 			}
 		}
 		scenario := &gauge.Scenario{Span: &gauge.Span{Start: token.LineNo, End: token.LineNo}}
@@ -147,11 +147,11 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		} else if isInState(*state, specScope) && spec.DataTable.IsInitialized() {
 			value := "Multiple data table present, ignoring table"
 			spec.AddComment(&gauge.Comment{Value: token.LineText, LineNo: token.LineNo})
-			return ParseResult{Ok: false, Warnings: []*Warning{&Warning{spec.FileName, token.LineNo, value}}}
+			return ParseResult{Ok: false, Warnings: []*Warning{&Warning{FileName: spec.FileName, LineNo: token.LineNo, Message: value}}}
 		} else {
 			value := "Data table not associated with spec"
 			spec.AddComment(&gauge.Comment{Value: token.LineText, LineNo: token.LineNo})
-			return ParseResult{Ok: false, Warnings: []*Warning{&Warning{spec.FileName, token.LineNo, value}}}
+			return ParseResult{Ok: false, Warnings: []*Warning{&Warning{FileName: spec.FileName, LineNo: token.LineNo, Message: value}}}
 		}
 		retainStates(state, specScope)
 		addStates(state, keywordScope)
@@ -181,10 +181,14 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				dataTable := &gauge.Table{LineNo: token.LineNo}
 				dataTable.AddHeaders(token.Args)
 				scn.AddDataTable(dataTable)
+			} else if !env.AllowScenarioDatatable() {
+				scn.AddComment(&gauge.Comment{Value: token.LineText, LineNo: token.LineNo})
+				return ParseResult{Ok: false, Warnings: []*Warning{
+					&Warning{FileName: spec.FileName, LineNo: token.LineNo, Message: "Table doesn't belong to any step", Code: OrphanTable}}}
 			} else {
 				scn.AddComment(&gauge.Comment{Value: token.LineText, LineNo: token.LineNo})
 				return ParseResult{Ok: false, Warnings: []*Warning{
-					&Warning{spec.FileName, token.LineNo, "Multiple data table present, ignoring table"}}}
+					&Warning{FileName: spec.FileName, LineNo: token.LineNo, Message: "Multiple data table present, ignoring table"}}}
 			}
 		} else {
 			if !spec.DataTable.Table.IsInitialized() {
@@ -193,8 +197,8 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				spec.AddDataTable(dataTable)
 			} else {
 				spec.AddComment(&gauge.Comment{Value: token.LineText, LineNo: token.LineNo})
-				return ParseResult{Ok: false, Warnings: []*Warning{&Warning{spec.FileName,
-					token.LineNo, "Multiple data table present, ignoring table"}}}
+				return ParseResult{Ok: false, Warnings: []*Warning{&Warning{FileName: spec.FileName,
+					LineNo: token.LineNo, Message: "Multiple data table present, ignoring table"}}}
 			}
 		}
 		retainStates(state, specScope, scenarioScope, stepScope, contextScope, tearDownScope)
@@ -330,7 +334,7 @@ func validateTableRows(token *Token, argLookup *gauge.ArgLookup, fileName string
 			param := match[0][1]
 			if !argLookup.ContainsArg(param) {
 				tableValues = append(tableValues, gauge.TableCell{Value: tableValue, CellType: gauge.Static})
-				warnings = append(warnings, &Warning{FileName: fileName, LineNo: token.LineNo, Message: fmt.Sprintf("Dynamic param <%s> could not be resolved, Treating it as static param", param)})
+				warnings = append(warnings, &Warning{FileName: fileName, LineNo: token.LineNo, Message: fmt.Sprintf("Dynamic param <%s> could not be resolved, Treating it as static param", param), Code: UnresolvedDynamicParam})
 			} else {
 				tableValues = append(tableValues, gauge.TableCell{Value: param, CellType: gauge.Dynamic})
 			}
@@ -340,3 +344,27 @@ func validateTableRows(token *Token, argLookup *gauge.ArgLookup, fileName string
 	}
 	return tableValues, warnings, error
 }
+
+// TableCellCountWarnings gives a warning for every table row whose number of cells differs from the number of headers
+// of its table. The parser does not report these, so they are only shown by tools which ask for them.
+func TableCellCountWarnings(text, fileName string) []*Warning {
+	tokens, _ := new(SpecParser).GenerateTokens(text, fileName)
+	var headers []string
+	var warnings []*Warning
+	underlined := false
+	for _, token := range tokens {
+		switch token.Kind {
+		case gauge.TableHeader:
+			headers, underlined = token.Args, false
+		case gauge.TableRow:
+			if !underlined && areUnderlined(token.Args) {
+				underlined = true
+				continue
+			}
+			if len(token.Args) != len(headers) {
+				warnings = append(warnings, &Warning{FileName: fileName, LineNo: token.LineNo, Message: fmt.Sprintf("Table row has %d cell(s), expected %d", len(token.Args), len(headers)), Code: TableRowCellCountMismatch})
+			}
+		}
+	}
+	return warnings
+}
@@ -37,6 +37,7 @@
 package parser
 
 import (
+	"context"
 	"runtime/debug"
 	"strings"
 
@@ -55,17 +56,27 @@ import (
 // Generates specifications and parse results.
 // TODO: Use single channel instead of one for spec and another for result, so that mapping is consistent
 func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, []*ParseResult) {
+	specs, parseResults, _ := ParseSpecFilesContext(context.Background(), specFiles, conceptDictionary, buildErrors)
+	return specs, parseResults
+}
+
+// ParseSpecFilesContext parses the spec files like ParseSpecFiles, but the files which are not yet parsed when the
+// context is done are skipped, and the error of the context is returned.
+func ParseSpecFilesContext(ctx context.Context, specFiles []string, conceptDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, []*ParseResult, error) {
 	parseResultsChan := make(chan *ParseResult, len(specFiles))
 	specsChan := make(chan *gauge.Specification, len(specFiles))
 	var parseResults []*ParseResult
 	var specs []*gauge.Specification
 
 	for _, specFile := range specFiles {
-		go parseSpec(specFile, conceptDictionary, specsChan, parseResultsChan)
+		go parseSpec(ctx, specFile, conceptDictionary, specsChan, parseResultsChan)
 	}
 	for range specFiles {
 		parseRes := <-parseResultsChan
 		spec := <-specsChan
+		if parseRes == nil {
+			continue
+		}
 		if spec != nil {
 			specs = append(specs, spec)
 			var parseErrs []error
@@ -78,7 +89,7 @@ func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictiona
 		}
 		parseResults = append(parseResults, parseRes)
 	}
-	return specs, parseResults
+	return specs, parseResults, ctx.Err()
 }
 
 // ParseSpecs parses specs in the give directory and gives specification and pass/fail status, used in validation.
@@ -104,8 +115,13 @@ func recoverPanic() {
 	}
 }
 
-func parseSpec(specFile string, conceptDictionary *gauge.ConceptDictionary, specChannel chan *gauge.Specification, parseResultChan chan *ParseResult) {
+func parseSpec(ctx context.Context, specFile string, conceptDictionary *gauge.ConceptDictionary, specChannel chan *gauge.Specification, parseResultChan chan *ParseResult) {
 	defer recoverPanic()
+	if ctx.Err() != nil {
+		specChannel <- nil
+		parseResultChan <- nil
+		return
+	}
 	specFileContent, err := common.ReadFileContents(specFile)
 	if err != nil {
 		specChannel <- nil
@@ -123,6 +139,11 @@ func parseSpec(specFile string, conceptDictionary *gauge.ConceptDictionary, spec
 type specFile struct {
 	filePath string
 	indices  []int
+	ids      []string
+}
+
+func (f *specFile) hasScenarioFilter() bool {
+	return len(f.indices) > 0 || len(f.ids) > 0
 }
 
 // parseSpecsInDirs parses all the specs in list of dirs given.
@@ -138,8 +159,8 @@ func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []str
 	for _, spec := range specs {
 		i, _ := getIndexFor(specFiles, spec.FileName)
 		specFile := specFiles[i]
-		if len(specFile.indices) > 0 {
-			spec.Filter(filter.NewScenarioFilterBasedOnSpan(specFile.indices))
+		if specFile.hasScenarioFilter() {
+			spec.Filter(filter.NewScenarioFilterBasedOnSpanOrID(specFile.indices, specFile.ids))
 		}
 		allSpecs[i] = spec
 	}
@@ -147,8 +168,11 @@ func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []str
 }
 
 func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specFile) {
+	var ids []string
 	for _, specSource := range specDirs {
-		if isIndexedSpec(specSource) {
+		if id, ok := gauge.ScenarioIDFromArg(specSource); ok {
+			ids = append(ids, id)
+		} else if isIndexedSpec(specSource) {
 			var specName string
 			specName, index := getIndexedSpecName(specSource)
 			files := util.GetSpecFiles([]string{specName})
@@ -156,7 +180,7 @@ func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specF
 				continue
 			}
 			specificationFile, created := addSpecFile(&specFiles, files[0])
-			if created || len(specificationFile.indices) > 0 {
+			if created || specificationFile.hasScenarioFilter() {
 				specificationFile.indices = append(specificationFile.indices, index)
 			}
 			givenSpecs = append(givenSpecs, files[0])
@@ -165,13 +189,50 @@ func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specF
 			for _, file := range files {
 				specificationFile, _ := addSpecFile(&specFiles, file)
 				specificationFile.indices = specificationFile.indices[0:0]
+				specificationFile.ids = nil
 			}
 			givenSpecs = append(givenSpecs, files...)
 		}
 	}
+	if len(ids) > 0 {
+		givenSpecs = append(givenSpecs, addSpecFilesWithScenarioIDs(&specFiles, ids)...)
+	}
 	return
 }
 
+// addSpecFilesWithScenarioIDs adds the spec files in the spec directories which have scenarios with the given IDs, so that
+// the scenarios are found even if they have been moved to another spec.
+func addSpecFilesWithScenarioIDs(specFiles *[]*specFile, ids []string) (givenSpecs []string) {
+	found := make(map[string]bool)
+	for _, file := range util.GetSpecFiles(util.GetSpecDirs()) {
+		content, err := common.ReadFileContents(file)
+		if err != nil {
+			continue
+		}
+		var idsInFile []string
+		for _, line := range strings.Split(content, "\n") {
+			if id := gauge.ScenarioIDFromComment(line); id != "" && util.ListContains(ids, id) {
+				idsInFile = append(idsInFile, id)
+				found[id] = true
+			}
+		}
+		if len(idsInFile) == 0 {
+			continue
+		}
+		specificationFile, created := addSpecFile(specFiles, file)
+		if created || specificationFile.hasScenarioFilter() {
+			specificationFile.ids = append(specificationFile.ids, idsInFile...)
+		}
+		givenSpecs = append(givenSpecs, file)
+	}
+	for _, id := range ids {
+		if !found[id] {
+			logger.Warningf(true, "No scenario found with ID %s", id)
+		}
+	}
+	return givenSpecs
+}
+
 func addSpecFile(specFiles *[]*specFile, file string) (*specFile, bool) {
 	i, exists := getIndexFor(*specFiles, file)
 	if !exists {
@@ -18,10 +18,13 @@
 package parser
 
 import (
+	"context"
+	"os"
 	"path/filepath"
 
 	"strings"
 
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	. "gopkg.in/check.v1"
 )
@@ -141,6 +144,26 @@ func (s *MySuite) TestSpecsFromArgsForIndexedArgsForMultipleSpecs(c *C) {
 	c.Assert(len(specs[1].Scenarios), Equals, 2)
 }
 
+func (s *MySuite) TestParseSpecFilesContextParsesSpecFiles(c *C) {
+	specFiles := []string{filepath.Join("testdata", "sample.spec"), filepath.Join("testdata", "sample2.spec")}
+	specs, results, err := ParseSpecFilesContext(context.Background(), specFiles, gauge.NewConceptDictionary(), gauge.NewBuildErrors())
+
+	c.Assert(err, IsNil)
+	c.Assert(len(specs), Equals, 2)
+	c.Assert(len(results), Equals, 2)
+}
+
+func (s *MySuite) TestParseSpecFilesContextSkipsSpecFilesWhenCancelled(c *C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	specFiles := []string{filepath.Join("testdata", "sample.spec"), filepath.Join("testdata", "sample2.spec")}
+	specs, results, err := ParseSpecFilesContext(ctx, specFiles, gauge.NewConceptDictionary(), gauge.NewBuildErrors())
+
+	c.Assert(err, Equals, context.Canceled)
+	c.Assert(len(specs), Equals, 0)
+	c.Assert(len(results), Equals, 0)
+}
+
 func (s *MySuite) TestSpecsFromArgsMaintainsOrderOfSpecsPassed(c *C) {
 	sampleSpec := filepath.Join("testdata", "sample.spec")
 	sample2Spec := filepath.Join("testdata", "sample2.spec")
@@ -225,6 +248,30 @@ func (s *MySuite) TestGetAllSpecsShouldDeDuplicateIndexedSpecs(c *C) {
 	}
 }
 
+func (s *MySuite) TestGetAllSpecsAddsSpecsWithScenarioIDs(c *C) {
+	os.Setenv(env.SpecsDir, filepath.Join("testdata", "scenarioIDs"))
+	defer os.Unsetenv(env.SpecsDir)
+
+	givenSpecs, indexedSpecs := getAllSpecFiles([]string{"id:first-scenario", "id:unknown"})
+
+	c.Assert(len(givenSpecs), Equals, 1)
+	c.Assert(len(indexedSpecs), Equals, 1)
+	c.Assert(strings.HasSuffix(indexedSpecs[0].filePath, "ids.spec"), Equals, true)
+	c.Assert(indexedSpecs[0].ids, DeepEquals, []string{"first-scenario"})
+}
+
+func (s *MySuite) TestParseSpecsInDirsFiltersScenariosByID(c *C) {
+	os.Setenv(env.SpecsDir, filepath.Join("testdata", "scenarioIDs"))
+	defer os.Unsetenv(env.SpecsDir)
+
+	specs, failed := parseSpecsInDirs(gauge.NewConceptDictionary(), []string{"id:first-scenario"}, gauge.NewBuildErrors())
+
+	c.Assert(failed, Equals, false)
+	c.Assert(len(specs), Equals, 1)
+	c.Assert(len(specs[0].Scenarios), Equals, 1)
+	c.Assert(specs[0].Scenarios[0].Heading.Value, Equals, "First")
+}
+
 func (s *MySuite) TestGetAllSpecsForIndexedNonExistingSpec(c *C) {
 	_, indexedSpecs := getAllSpecFiles([]string{"example.spec" + ":1"})
 
@@ -25,8 +25,20 @@ type ParseError struct {
 	LineNo   int
 	Message  string
 	LineText string
+	Code     ErrorCode
 }
 
+// ErrorCode identifies the kind of a parse error or a warning, so that tools can act on it without matching messages.
+type ErrorCode string
+
+const (
+	SpecHeadingNotFound       ErrorCode = "spec-heading-not-found"
+	DuplicateScenario         ErrorCode = "duplicate-scenario"
+	UnresolvedDynamicParam    ErrorCode = "unresolved-dynamic-param"
+	OrphanTable               ErrorCode = "orphan-table"
+	TableRowCellCountMismatch ErrorCode = "table-row-cell-count-mismatch"
+)
+
 // Error prints error with filename, line number, error message and step text.
 func (se ParseError) Error() string {
 	if se.LineNo == 0 && se.FileName == "" {
@@ -60,6 +72,7 @@ type Warning struct {
 	FileName string
 	LineNo   int
 	Message  string
+	Code     ErrorCode
 }
 
 func (warning *Warning) String() string {
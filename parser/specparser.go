@@ -111,7 +111,7 @@ func (parser *SpecParser) validateSpec(specification *gauge.Specification) error
 	}
 	if specification.Heading == nil {
 		specification.AddHeading(&gauge.Heading{})
-		return ParseError{FileName: specification.FileName, LineNo: 1, Message: "Spec heading not found"}
+		return ParseError{FileName: specification.FileName, LineNo: 1, Message: "Spec heading not found", Code: SpecHeadingNotFound}
 	}
 	if len(strings.TrimSpace(specification.Heading.Value)) < 1 {
 		return ParseError{FileName: specification.FileName, LineNo: specification.Heading.LineNo, Message: "Spec heading should have at least one character"}
@@ -149,7 +149,7 @@ func createStep(spec *gauge.Specification, scn *gauge.Scenario, stepToken *Token
 func CreateStepUsingLookup(stepToken *Token, lookup *gauge.ArgLookup, specFileName string) (*gauge.Step, *ParseResult) {
 	stepValue, argsType := extractStepValueAndParameterTypes(stepToken.Value)
 	if argsType != nil && len(argsType) != len(stepToken.Args) {
-		return nil, &ParseResult{ParseErrors: []ParseError{ParseError{specFileName, stepToken.LineNo, "Step text should not have '{static}' or '{dynamic}' or '{special}'", stepToken.LineText}}, Warnings: nil}
+		return nil, &ParseResult{ParseErrors: []ParseError{ParseError{FileName: specFileName, LineNo: stepToken.LineNo, Message: "Step text should not have '{static}' or '{dynamic}' or '{special}'", LineText: stepToken.LineText}}, Warnings: nil}
 	}
 	step := &gauge.Step{FileName: specFileName, LineNo: stepToken.LineNo, Value: stepValue, LineText: strings.TrimSpace(stepToken.LineText)}
 	arguments := make([]*gauge.StepArg, 0)
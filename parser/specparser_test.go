@@ -157,8 +157,10 @@ func (s *MySuite) TestThrowsErrorForScenarioWithoutSpecHeading(c *C) {
 
 	c.Assert(result.ParseErrors[0].Message, Equals, "Spec heading not found")
 	c.Assert(result.ParseErrors[0].LineNo, Equals, 1)
+	c.Assert(result.ParseErrors[0].Code, Equals, SpecHeadingNotFound)
 	c.Assert(result.ParseErrors[1].Message, Equals, "Scenario should be defined after the spec heading")
 	c.Assert(result.ParseErrors[1].LineNo, Equals, 1)
+	c.Assert(result.ParseErrors[1].Code, Equals, SpecHeadingNotFound)
 
 }
 
@@ -175,6 +177,7 @@ func (s *MySuite) TestThrowsErrorForDuplicateScenariosWithinTheSameSpec(c *C) {
 	c.Assert(result.Ok, Equals, false)
 
 	c.Assert(result.ParseErrors[0].Message, Equals, "Duplicate scenario definition 'Scenario Heading' found in the same specification")
+	c.Assert(result.ParseErrors[0].Code, Equals, DuplicateScenario)
 	c.Assert(result.ParseErrors[0].LineNo, Equals, 4)
 }
 
@@ -1308,3 +1311,47 @@ func (s *MySuite) TestDataTableForSpecialParameterWhenFileIsNotFound(c *C) {
 	c.Assert(res.ParseErrors[0].Message, Equals, "Dynamic param <file:notFound.txt> could not be resolved, Missing file: notFound.txt")
 	c.Assert(res.ParseErrors[0].LineText, Equals, "|james|<file:notFound.txt>|")
 }
+
+func (s *MySuite) TestWarningForTableRowWithWrongNumberOfCells(c *C) {
+	specText := newSpecBuilder().specHeading("Spec heading").text("|name|id|").text("|---|---|").text("|john|").scenarioHeading("First scenario").step("my step").text("|a|b|").text("|1|2|3|").String()
+
+	warnings := TableCellCountWarnings(specText, "foo.spec")
+
+	c.Assert(len(warnings), Equals, 2)
+	c.Assert(warnings[0].String(), Equals, "foo.spec:4 Table row has 1 cell(s), expected 2")
+	c.Assert(warnings[0].Code, Equals, TableRowCellCountMismatch)
+	c.Assert(warnings[1].String(), Equals, "foo.spec:8 Table row has 3 cell(s), expected 2")
+	c.Assert(warnings[1].Code, Equals, TableRowCellCountMismatch)
+}
+
+func (s *MySuite) TestParserDoesNotWarnForTableRowWithWrongNumberOfCells(c *C) {
+	parser := new(SpecParser)
+	specText := newSpecBuilder().specHeading("Spec heading").text("|name|id|").text("|---|---|").text("|john|").scenarioHeading("First scenario").step("my step").String()
+
+	_, res := parser.ParseSpecText(specText, "foo.spec")
+
+	c.Assert(len(res.Warnings), Equals, 0)
+}
+
+func (s *MySuite) TestWarningForTableWhichDoesNotBelongToAnyStep(c *C) {
+	env.AllowScenarioDatatable = func() bool { return false }
+	parser := new(SpecParser)
+	specText := newSpecBuilder().specHeading("Spec heading").scenarioHeading("First scenario").text("some comment").text("|a|b|").text("|1|2|").step("my step").String()
+
+	_, res := parser.ParseSpecText(specText, "foo.spec")
+
+	c.Assert(len(res.Warnings), Equals, 1)
+	c.Assert(res.Warnings[0].String(), Equals, "foo.spec:4 Table doesn't belong to any step")
+	c.Assert(res.Warnings[0].Code, Equals, OrphanTable)
+}
+
+func (s *MySuite) TestUnresolvedDynamicParamErrorHasCode(c *C) {
+	parser := new(SpecParser)
+	specText := newSpecBuilder().specHeading("Spec heading").scenarioHeading("First scenario").step("my step with <foo>").String()
+
+	_, res := parser.ParseSpecText(specText, "")
+
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Dynamic parameter <foo> could not be resolved")
+	c.Assert(res.ParseErrors[0].Code, Equals, UnresolvedDynamicParam)
+}
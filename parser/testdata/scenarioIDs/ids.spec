@@ -0,0 +1,8 @@
+# Scenario IDs
+
+## First
+<!-- scenario-id: first-scenario -->
+* step one
+
+## Second
+* step two
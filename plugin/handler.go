@@ -57,31 +57,43 @@ func (gp *GaugePlugins) NotifyPlugins(message *gauge_messages.Message) {
 	}
 
 	for id, plugin := range gp.pluginsMap {
-		if !plugin.descriptor.hasCapability(streamResultCapability) {
+		if !plugin.descriptor.hasCapability(streamResultCapability) || message.MessageType != gauge_messages.Message_SuiteExecutionResult {
 			handle(id, plugin, plugin.sendMessage(message))
 			continue
 		}
-		items := []*gauge_messages.ProtoItem{}
-		for _, sr := range message.SuiteExecutionResult.GetSuiteResult().GetSpecResults() {
-			for _, i := range sr.ProtoSpec.Items {
-				i.FileName = sr.ProtoSpec.FileName
-				items = append(items, i)
-			}
-			sr.ProtoSpec.ItemCount = int64(len(sr.ProtoSpec.Items))
-			sr.ProtoSpec.Items = nil
+		res, items := chunkSuiteResult(message.SuiteExecutionResult.GetSuiteResult())
+		m := &gauge_messages.Message{MessageType: gauge_messages.Message_SuiteExecutionResult, SuiteExecutionResult: &gauge_messages.SuiteExecutionResult{SuiteResult: res}}
+		handle(id, plugin, plugin.sendMessage(m))
+		for _, i := range items {
+			m := &gauge_messages.Message{MessageType: gauge_messages.Message_SuiteExecutionResultItem, SuiteExecutionResultItem: &gauge_messages.SuiteExecutionResultItem{ResultItem: i}}
+			handle(id, plugin, plugin.sendMessage(m))
 		}
-		if message.MessageType == gauge_messages.Message_SuiteExecutionResult {
-			message.SuiteExecutionResult.SuiteResult.Chunked = true
-			message.SuiteExecutionResult.SuiteResult.ChunkSize = int64(len(items))
-			handle(id, plugin, plugin.sendMessage(message))
-			for _, i := range items {
-				m := &gauge_messages.Message{MessageType: gauge_messages.Message_SuiteExecutionResultItem, SuiteExecutionResultItem: &gauge_messages.SuiteExecutionResultItem{ResultItem: i}}
-				handle(id, plugin, plugin.sendMessage(m))
-			}
-		} else {
-			handle(id, plugin, plugin.sendMessage(message))
+	}
+}
+
+// chunkSuiteResult splits the suite result for plugins which can receive it as a stream. It returns a copy of the
+// suite result without the spec items, and the items which are sent after it. The given suite result is left
+// intact, so that it can be sent to other plugins as well.
+func chunkSuiteResult(res *gauge_messages.ProtoSuiteResult) (*gauge_messages.ProtoSuiteResult, []*gauge_messages.ProtoItem) {
+	items := []*gauge_messages.ProtoItem{}
+	chunked := *res
+	chunked.SpecResults = nil
+	for _, sr := range res.GetSpecResults() {
+		for _, i := range sr.ProtoSpec.Items {
+			item := *i
+			item.FileName = sr.ProtoSpec.FileName
+			items = append(items, &item)
 		}
+		specResult := *sr
+		protoSpec := *sr.ProtoSpec
+		protoSpec.ItemCount = int64(len(sr.ProtoSpec.Items))
+		protoSpec.Items = nil
+		specResult.ProtoSpec = &protoSpec
+		chunked.SpecResults = append(chunked.SpecResults, &specResult)
 	}
+	chunked.Chunked = true
+	chunked.ChunkSize = int64(len(items))
+	return &chunked, items
 }
 
 func (gp *GaugePlugins) killPlugin(pluginID string) {
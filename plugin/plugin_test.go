@@ -26,6 +26,7 @@ import (
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/plugin/pluginInfo"
 	"github.com/getgauge/gauge/version"
 )
@@ -147,3 +148,29 @@ func TestGetPluginsWithoutScope(t *testing.T) {
 		t.Errorf("Failed GetPluginWithoutScope.\n\tWant: %v\n\tGot: %v", want, got)
 	}
 }
+
+func TestChunkSuiteResultLeavesTheSuiteResultIntact(t *testing.T) {
+	items := []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "scenario1"}},
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "scenario2"}},
+	}
+	res := &gauge_messages.ProtoSuiteResult{SpecResults: []*gauge_messages.ProtoSpecResult{
+		{ProtoSpec: &gauge_messages.ProtoSpec{FileName: "foo.spec", Items: items}},
+	}}
+
+	chunked, got := chunkSuiteResult(res)
+
+	want := []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "scenario1"}, FileName: "foo.spec"},
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "scenario2"}, FileName: "foo.spec"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Failed chunkSuiteResult.\n\tWant: %v\n\tGot: %v", want, got)
+	}
+	if !chunked.Chunked || chunked.ChunkSize != 2 || chunked.SpecResults[0].ProtoSpec.ItemCount != 2 || chunked.SpecResults[0].ProtoSpec.Items != nil {
+		t.Errorf("Failed chunkSuiteResult. Got chunked result: %v", chunked)
+	}
+	if res.Chunked || len(res.SpecResults[0].ProtoSpec.Items) != 2 || res.SpecResults[0].ProtoSpec.Items[0].FileName != "" {
+		t.Errorf("Expected suite result to be left intact. Got: %v", res)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package refactor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+type conceptRenamer struct {
+	oldStep *gauge.Step
+	newStep *gauge.Step
+	concept *gauge.Concept
+	// orderMap maps the position of a parameter in the new heading to its position in the old heading.
+	orderMap map[int]int
+	// renamedParams maps the old names of the parameters to their new names.
+	renamedParams map[string]string
+}
+
+// GetConceptRenameChanges gives the changes made by renaming the concept heading oldHeading to newHeading in the concept
+// definition and in all its usages in specifications and concepts. Parameters are matched by name first and then by position.
+// No file is changed and the runner is not needed. Renaming stops with a failure once the context is done.
+func GetConceptRenameChanges(ctx context.Context, oldHeading, newHeading string, specDirs []string) *refactoringResult {
+	if strings.TrimSpace(oldHeading) == strings.TrimSpace(newHeading) {
+		return &refactoringResult{Success: true}
+	}
+	agent, errs := getRefactorAgent(oldHeading, newHeading, nil)
+	if len(errs) > 0 {
+		var messages []string
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return rephraseFailure(messages...)
+	}
+	result, specs, conceptDictionary := parseSpecsAndConcepts(ctx, specDirs)
+	if !result.Success {
+		return result
+	}
+	if err := ctx.Err(); err != nil {
+		return rephraseFailure(err.Error())
+	}
+	renamer, err := newConceptRenamer(agent.oldStep, agent.newStep, conceptDictionary)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	specsRefactored, conceptsRefactored := renamer.rename(specs, conceptDictionary)
+	result.SpecsChanged, result.ConceptsChanged = getFileChanges(specs, conceptDictionary, specsRefactored, conceptsRefactored)
+	sortFileChanges(result.SpecsChanged)
+	sortFileChanges(result.ConceptsChanged)
+	return result
+}
+
+// RenameConcept renames the concept heading oldHeading to newHeading and writes the changes to the specification and concept files.
+func RenameConcept(oldHeading, newHeading string, specDirs []string) *refactoringResult {
+	result := GetConceptRenameChanges(context.Background(), oldHeading, newHeading, specDirs)
+	if result.Success {
+		writeFileChangesToDisk(result)
+	}
+	return result
+}
+
+// RefactorConcept renames the concept heading and prints every change made along with the refactoring summary.
+func RefactorConcept(oldHeading, newHeading string, specDirs []string) {
+	result := RenameConcept(oldHeading, newHeading, specDirs)
+	printFileChanges(result)
+	printRefactoringSummary(result)
+}
+
+func printFileChanges(result *refactoringResult) {
+	for _, fileChange := range append(result.SpecsChanged, result.ConceptsChanged...) {
+		name := util.RelPathToProjectRoot(fileChange.FileName)
+		for _, diff := range fileChange.Diffs {
+			logger.Info(true, fmt.Sprintf("%s:%d %s", name, diff.GetSpan().GetStart(), strings.Split(diff.GetContent(), "\n")[0]))
+		}
+	}
+}
+
+// PreviewConcept prints the changes renaming the concept heading would make as a unified diff, or as JSON if machineReadable is set,
+// without changing any file.
+func PreviewConcept(oldHeading, newHeading string, specDirs []string, machineReadable bool) {
+	printRefactoringPreview(previewOf(GetConceptRenameChanges(context.Background(), oldHeading, newHeading, specDirs)), machineReadable)
+}
+
+func newConceptRenamer(oldStep, newStep *gauge.Step, conceptDictionary *gauge.ConceptDictionary) (*conceptRenamer, error) {
+	concept := conceptDictionary.Search(oldStep.Value)
+	if concept == nil {
+		return nil, fmt.Errorf("Concept not found: %s", oldStep.LineText)
+	}
+	if c := conceptDictionary.Search(newStep.Value); c != nil && c != concept {
+		return nil, fmt.Errorf("Concept already exists: %s", c.ConceptStep.LineText)
+	}
+	for _, arg := range newStep.Args {
+		if arg.ArgType != gauge.Dynamic {
+			return nil, fmt.Errorf("Concept heading can have only dynamic parameters: %s", newStep.LineText)
+		}
+	}
+	renamer := &conceptRenamer{oldStep: oldStep, newStep: newStep, concept: concept, renamedParams: make(map[string]string)}
+	oldArgs := concept.ConceptStep.Args
+	renamer.orderMap = make(map[int]int, len(newStep.Args))
+	used := make(map[int]bool, len(oldArgs))
+	for i, arg := range newStep.Args {
+		renamer.orderMap[i] = SliceIndex(len(oldArgs), func(j int) bool { return !used[j] && oldArgs[j].Value == arg.Value })
+		if renamer.orderMap[i] != -1 {
+			used[renamer.orderMap[i]] = true
+		}
+	}
+	for i, arg := range newStep.Args {
+		if renamer.orderMap[i] != -1 {
+			continue
+		}
+		renamer.orderMap[i] = SliceIndex(len(oldArgs), func(j int) bool { return !used[j] })
+		if renamer.orderMap[i] == -1 {
+			return nil, fmt.Errorf("Parameter <%s> does not have a value in the usages of the concept", arg.Value)
+		}
+		used[renamer.orderMap[i]] = true
+		renamer.renamedParams[oldArgs[renamer.orderMap[i]].Value] = arg.Value
+	}
+	for j, arg := range oldArgs {
+		if used[j] {
+			continue
+		}
+		for _, step := range concept.ConceptStep.ConceptSteps {
+			if step.UsesDynamicArgs(arg.Value) {
+				return nil, fmt.Errorf("Parameter <%s> is removed but used in the concept step: %s", arg.Value, step.LineText)
+			}
+		}
+	}
+	return renamer, nil
+}
+
+func (renamer *conceptRenamer) rename(specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary) (map[*gauge.Specification][]*gauge.StepDiff, map[string][]*gauge.StepDiff) {
+	specsRefactored := make(map[*gauge.Specification][]*gauge.StepDiff, 0)
+	conceptsRefactored := make(map[string][]*gauge.StepDiff, 0)
+	for _, spec := range specs {
+		diffs, isRefactored := spec.RenameSteps(*renamer.oldStep, *renamer.newStep, renamer.orderMap)
+		if isRefactored {
+			for _, diff := range diffs {
+				diff.IsConcept = false
+			}
+			specsRefactored[spec] = diffs
+		}
+	}
+	for _, concept := range conceptDictionary.ConceptsMap {
+		isConcept := false
+		for _, item := range concept.ConceptStep.Items {
+			if item.Kind() != gauge.StepKind || item.(*gauge.Step) == concept.ConceptStep {
+				continue
+			}
+			diff, isRefactored := item.(*gauge.Step).Rename(*renamer.oldStep, *renamer.newStep, false, renamer.orderMap, &isConcept)
+			if isRefactored {
+				diff.IsConcept = false
+				conceptsRefactored[concept.FileName] = append(conceptsRefactored[concept.FileName], diff)
+			}
+		}
+	}
+	file := renamer.concept.FileName
+	conceptsRefactored[file] = append(conceptsRefactored[file], renamer.renameHeading())
+	for _, step := range renamer.concept.ConceptStep.ConceptSteps {
+		if diff := renamer.renameParamsIn(step); diff != nil {
+			conceptsRefactored[file] = append(conceptsRefactored[file], diff)
+		}
+	}
+	return specsRefactored, conceptsRefactored
+}
+
+func (renamer *conceptRenamer) renameHeading() *gauge.StepDiff {
+	heading := renamer.concept.ConceptStep
+	diff := &gauge.StepDiff{OldStep: *heading, IsConcept: true}
+	heading.Value = renamer.newStep.Value
+	heading.LineText = renamer.newStep.LineText
+	heading.Args = make([]*gauge.StepArg, len(renamer.newStep.Args))
+	for i, arg := range renamer.newStep.Args {
+		heading.Args[i] = &gauge.StepArg{Name: arg.Value, Value: arg.Value, ArgType: gauge.Dynamic}
+	}
+	diff.NewStep = heading
+	return diff
+}
+
+// renameParamsIn replaces the args of the step using renamed parameters, so that the old step of the diff is left unchanged.
+func (renamer *conceptRenamer) renameParamsIn(step *gauge.Step) *gauge.StepDiff {
+	diff := &gauge.StepDiff{OldStep: *step}
+	isRenamed := false
+	args := make([]*gauge.StepArg, len(step.Args))
+	for i, arg := range step.Args {
+		args[i] = arg
+		if newName, ok := renamer.renamedParams[arg.Value]; ok && arg.ArgType == gauge.Dynamic {
+			args[i] = &gauge.StepArg{Name: newName, Value: newName, ArgType: gauge.Dynamic}
+			isRenamed = true
+		}
+		if table, ok := renamer.renameParamsInTable(&arg.Table); ok && arg.ArgType == gauge.TableArg {
+			args[i] = &gauge.StepArg{Name: arg.Name, Value: arg.Value, ArgType: arg.ArgType, Table: *table}
+			isRenamed = true
+		}
+	}
+	if !isRenamed {
+		return nil
+	}
+	step.Args = args
+	diff.NewStep = step
+	return diff
+}
+
+func (renamer *conceptRenamer) renameParamsInTable(table *gauge.Table) (*gauge.Table, bool) {
+	isRenamed := false
+	columns := make([][]gauge.TableCell, len(table.Columns))
+	for i, cells := range table.Columns {
+		columns[i] = make([]gauge.TableCell, len(cells))
+		for j, cell := range cells {
+			columns[i][j] = cell
+			if newName, ok := renamer.renamedParams[cell.Value]; ok && cell.CellType == gauge.Dynamic {
+				columns[i][j] = gauge.TableCell{Value: newName, CellType: gauge.Dynamic}
+				isRenamed = true
+			}
+		}
+	}
+	return gauge.NewTable(table.Headers, columns, table.LineNo), isRenamed
+}
+
+func sortFileChanges(fileChanges []*gauge_messages.FileChanges) {
+	sort.Slice(fileChanges, func(i, j int) bool { return fileChanges[i].FileName < fileChanges[j].FileName })
+	for _, fileChange := range fileChanges {
+		diffs := fileChange.Diffs
+		sort.SliceStable(diffs, func(i, j int) bool { return diffs[i].GetSpan().GetStart() < diffs[j].GetSpan().GetStart() })
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package refactor
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+func createConceptProject(c *C, spec, cpt string) string {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	c.Assert(os.Mkdir(filepath.Join(dir, "specs"), 0755), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "specs", "example.spec"), []byte(spec), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "specs", "example.cpt"), []byte(cpt), 0644), IsNil)
+	return dir
+}
+
+func fileChangeFor(fileChanges []*gauge_messages.FileChanges, name string) *gauge_messages.FileChanges {
+	for _, fileChange := range fileChanges {
+		if filepath.Base(fileChange.FileName) == name {
+			return fileChange
+		}
+	}
+	return nil
+}
+
+func (s *MySuite) TestConceptRenameReordersArgsInUsagesAndRenamesParams(c *C) {
+	dir := createConceptProject(c, "# Spec\n## Scenario\n* login as \"admin\" with \"secret\"\n",
+		"# login as <user> with <password>\n* enter <user>\n* enter password <password>\n\n# setup\n* login as \"guest\" with \"none\"\n")
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := GetConceptRenameChanges(context.Background(), "login as <user> with <password>", "sign in with <password> as <name>", []string{"specs"})
+
+	c.Assert(res.Errors, HasLen, 0)
+	c.Assert(res.Success, Equals, true)
+	c.Assert(fileChangeFor(res.SpecsChanged, "example.spec").FileContent, Equals, "# Spec\n## Scenario\n* sign in with \"secret\" as \"admin\"\n")
+	cpt := fileChangeFor(res.ConceptsChanged, "example.cpt")
+	c.Assert(cpt.FileContent, Equals, "# sign in with <password> as <name>\n* enter <name>\n* enter password <password>\n\n# setup\n* sign in with \"none\" as \"guest\"\n")
+	c.Assert(cpt.Diffs, HasLen, 3)
+	c.Assert(cpt.Diffs[0].Content, Equals, "# sign in with <password> as <name>")
+	c.Assert(cpt.Diffs[1].Content, Equals, "* enter <name>")
+	c.Assert(cpt.Diffs[2].Content, Equals, "* sign in with \"none\" as \"guest\"")
+	span := cpt.Diffs[2].Span
+	c.Assert([]int64{span.Start, span.StartChar, span.End, span.EndChar}, DeepEquals, []int64{6, 0, 6, 30})
+	c.Assert(fileChangeFor(res.SpecsChanged, "example.spec").Diffs[0].Content, Equals, "* sign in with \"secret\" as \"admin\"")
+}
+
+func (s *MySuite) TestConceptRenameFailsForUnknownConcept(c *C) {
+	dir := createConceptProject(c, "# Spec\n## Scenario\n* say hello\n", "# greet <name>\n* say hello to <name>\n")
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := GetConceptRenameChanges(context.Background(), "welcome <name>", "hello <name>", []string{"specs"})
+
+	c.Assert(res.Success, Equals, false)
+	c.Assert(res.Errors, DeepEquals, []string{"Concept not found: welcome <name>"})
+}
+
+func (s *MySuite) TestConceptRenameFailsWhenCancelled(c *C) {
+	dir := createConceptProject(c, "# Spec\n## Scenario\n* greet \"john\"\n", "# greet <name>\n* say hello to <name>\n")
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := GetConceptRenameChanges(ctx, "greet <name>", "welcome <name>", []string{"specs"})
+
+	c.Assert(res.Success, Equals, false)
+	c.Assert(res.Errors, DeepEquals, []string{context.Canceled.Error()})
+	c.Assert(res.SpecsChanged, HasLen, 0)
+}
+
+func (s *MySuite) TestConceptRenameFailsWhenRemovedParamIsUsed(c *C) {
+	dir := createConceptProject(c, "# Spec\n## Scenario\n* greet \"john\"\n", "# greet <name>\n* say hello to <name>\n")
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := GetConceptRenameChanges(context.Background(), "greet <name>", "greet everyone", []string{"specs"})
+
+	c.Assert(res.Success, Equals, false)
+	c.Assert(res.Errors, DeepEquals, []string{"Parameter <name> is removed but used in the concept step: say hello to <name>"})
+}
+
+func (s *MySuite) TestConceptRenameFailsWhenNewParamHasNoValue(c *C) {
+	dir := createConceptProject(c, "# Spec\n## Scenario\n* greet \"john\"\n", "# greet <name>\n* say hello to <name>\n")
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := GetConceptRenameChanges(context.Background(), "greet <name>", "greet <name> at <place>", []string{"specs"})
+
+	c.Assert(res.Success, Equals, false)
+	c.Assert(res.Errors, DeepEquals, []string{"Parameter <place> does not have a value in the usages of the concept"})
+}
@@ -0,0 +1,254 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package refactor
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+type conceptInliner struct {
+	concept *gauge.Concept
+	// file and line locate the usage to inline. Every usage is inlined if file is empty.
+	file       string
+	line       int
+	diffs      map[string][]*gauge_messages.TextDiff
+	contents   map[string]string
+	usagesLeft int
+}
+
+// GetInlineConceptChanges gives the changes made by replacing the usages of a concept with the steps of the concept, substituting
+// the arguments of the usage for the parameters of the concept. conceptText is the concept heading or a usage of the concept.
+// Only the usage at the given file and line is inlined, or every usage if file is empty. The concept definition is removed
+// if removeDefinition is set and the concept has no usages left. No file is changed and the runner is not needed.
+func GetInlineConceptChanges(conceptText, file string, line int, removeDefinition bool, specDirs []string) *refactoringResult {
+	tokens, errs := new(parser.SpecParser).GenerateTokens("* "+conceptText, "")
+	if len(errs) > 0 {
+		var messages []string
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return rephraseFailure(messages...)
+	}
+	step, parseRes := parser.CreateStepUsingLookup(tokens[0], nil, "")
+	if parseRes != nil && len(parseRes.ParseErrors) > 0 {
+		return rephraseFailure(parseRes.Errors()...)
+	}
+	result, specs, conceptDictionary := parseSpecsAndConcepts(context.Background(), specDirs)
+	if !result.Success {
+		return result
+	}
+	concept := conceptDictionary.Search(step.Value)
+	if concept == nil {
+		return rephraseFailure(fmt.Sprintf("Concept not found: %s", conceptText))
+	}
+	if file != "" {
+		file, _ = filepath.Abs(file)
+	}
+	inliner := &conceptInliner{concept: concept, file: file, line: line, diffs: make(map[string][]*gauge_messages.TextDiff), contents: make(map[string]string)}
+	for _, spec := range specs {
+		for _, s := range spec.Steps() {
+			if err := inliner.inline(s, spec.FileName); err != nil {
+				return rephraseFailure(err.Error())
+			}
+		}
+	}
+	for _, c := range conceptDictionary.ConceptsMap {
+		if c == concept {
+			continue
+		}
+		for _, s := range c.ConceptStep.ConceptSteps {
+			if err := inliner.inline(s, c.FileName); err != nil {
+				return rephraseFailure(err.Error())
+			}
+		}
+	}
+	if len(inliner.diffs) == 0 {
+		if file != "" {
+			return rephraseFailure(fmt.Sprintf("Concept is not used at %s:%d", util.RelPathToProjectRoot(file), line))
+		}
+		result.Warnings = append(result.Warnings, fmt.Sprintf("Concept is not used: %s", concept.ConceptStep.LineText))
+	}
+	if removeDefinition {
+		if inliner.usagesLeft > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("Concept definition is not removed as it is still used in %d places", inliner.usagesLeft))
+		} else if err := inliner.removeDefinition(conceptDictionary); err != nil {
+			return rephraseFailure(err.Error())
+		}
+	}
+	for fileName, diffs := range inliner.diffs {
+		fileChange := &gauge_messages.FileChanges{FileName: fileName, FileContent: applyTextDiffs(inliner.contents[fileName], diffs), Diffs: diffs}
+		if util.IsConcept(fileName) {
+			result.ConceptsChanged = append(result.ConceptsChanged, fileChange)
+		} else {
+			result.SpecsChanged = append(result.SpecsChanged, fileChange)
+		}
+	}
+	sortFileChanges(result.SpecsChanged)
+	sortFileChanges(result.ConceptsChanged)
+	return result
+}
+
+// InlineConcept inlines the usages of a concept and writes the changes to the specification and concept files.
+func InlineConcept(conceptText, file string, line int, removeDefinition bool, specDirs []string) *refactoringResult {
+	result := GetInlineConceptChanges(conceptText, file, line, removeDefinition, specDirs)
+	if result.Success {
+		writeFileChangesToDisk(result)
+	}
+	return result
+}
+
+// RefactorInlineConcept inlines the usages of a concept and prints every change made along with the refactoring summary.
+func RefactorInlineConcept(conceptText, file string, line int, removeDefinition bool, specDirs []string) {
+	result := InlineConcept(conceptText, file, line, removeDefinition, specDirs)
+	printFileChanges(result)
+	printRefactoringSummary(result)
+}
+
+// PreviewInlineConcept prints the changes inlining the usages of a concept would make as a unified diff, or as JSON if
+// machineReadable is set, without changing any file.
+func PreviewInlineConcept(conceptText, file string, line int, removeDefinition bool, specDirs []string, machineReadable bool) {
+	printRefactoringPreview(previewOf(GetInlineConceptChanges(conceptText, file, line, removeDefinition, specDirs)), machineReadable)
+}
+
+func (inliner *conceptInliner) inline(step *gauge.Step, fileName string) error {
+	if step.Value != inliner.concept.ConceptStep.Value {
+		return nil
+	}
+	if inliner.file != "" && (inliner.file != fileName || inliner.line != step.LineNo) {
+		inliner.usagesLeft++
+		return nil
+	}
+	lines, err := inliner.linesOf(fileName)
+	if err != nil {
+		return err
+	}
+	end := usageEndLine(lines, step)
+	text := ""
+	for _, s := range inliner.stepsFor(step) {
+		text += formatter.FormatStep(s)
+	}
+	inliner.diffs[fileName] = append(inliner.diffs[fileName], &gauge_messages.TextDiff{
+		Span:    &gauge_messages.Span{Start: int64(step.LineNo), StartChar: 0, End: int64(end), EndChar: int64(len(lines[end-1]))},
+		Content: strings.TrimSuffix(text, "\n"),
+	})
+	return nil
+}
+
+func (inliner *conceptInliner) linesOf(fileName string) ([]string, error) {
+	if _, ok := inliner.contents[fileName]; !ok {
+		content, err := common.ReadFileContents(fileName)
+		if err != nil {
+			return nil, err
+		}
+		inliner.contents[fileName] = content
+	}
+	return strings.Split(inliner.contents[fileName], "\n"), nil
+}
+
+// usageEndLine gives the last line of the step, which is the last row of the inline table if the step has one.
+func usageEndLine(lines []string, step *gauge.Step) int {
+	end := step.LineNo
+	if !step.HasInlineTable {
+		return end
+	}
+	for i := step.LineNo; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(line, "|") {
+			end = i + 1
+		} else if line != "" || end > step.LineNo {
+			break
+		}
+	}
+	return end
+}
+
+func (inliner *conceptInliner) stepsFor(usage *gauge.Step) []*gauge.Step {
+	params := make(map[string]*gauge.StepArg)
+	for i, arg := range inliner.concept.ConceptStep.Args {
+		if i < len(usage.Args) {
+			params[arg.Value] = usage.Args[i]
+		}
+	}
+	var steps []*gauge.Step
+	for _, s := range inliner.concept.ConceptStep.ConceptSteps {
+		step := &gauge.Step{Value: s.Value, LineText: s.LineText, Suffix: s.Suffix, Args: make([]*gauge.StepArg, len(s.Args))}
+		for i, arg := range s.Args {
+			step.Args[i] = inlineArg(arg, params)
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func inlineArg(arg *gauge.StepArg, params map[string]*gauge.StepArg) *gauge.StepArg {
+	if value, ok := params[arg.Value]; ok && arg.ArgType == gauge.Dynamic {
+		return value
+	}
+	if arg.ArgType != gauge.TableArg {
+		return arg
+	}
+	columns := make([][]gauge.TableCell, len(arg.Table.Columns))
+	for i, cells := range arg.Table.Columns {
+		columns[i] = make([]gauge.TableCell, len(cells))
+		for j, cell := range cells {
+			columns[i][j] = cell
+			if value, ok := params[cell.Value]; ok && cell.CellType == gauge.Dynamic {
+				columns[i][j] = inlineCell(value)
+			}
+		}
+	}
+	return &gauge.StepArg{Name: arg.Name, Value: arg.Value, ArgType: arg.ArgType, Table: *gauge.NewTable(arg.Table.Headers, columns, arg.Table.LineNo)}
+}
+
+func inlineCell(value *gauge.StepArg) gauge.TableCell {
+	switch value.ArgType {
+	case gauge.Dynamic:
+		return gauge.TableCell{Value: value.Value, CellType: gauge.Dynamic}
+	case gauge.SpecialString, gauge.SpecialTable:
+		return gauge.TableCell{Value: value.Name, CellType: gauge.SpecialString}
+	default:
+		return gauge.TableCell{Value: value.Value, CellType: gauge.Static}
+	}
+}
+
+// removeDefinition removes the lines from the concept heading to the next concept heading in the file, or to the end of the file.
+func (inliner *conceptInliner) removeDefinition(conceptDictionary *gauge.ConceptDictionary) error {
+	fileName := inliner.concept.FileName
+	lines, err := inliner.linesOf(fileName)
+	if err != nil {
+		return err
+	}
+	start := inliner.concept.ConceptStep.LineNo
+	span := &gauge_messages.Span{Start: int64(start), End: int64(len(lines)), EndChar: int64(len(lines[len(lines)-1]))}
+	for _, c := range conceptDictionary.ConceptsMap {
+		if c.FileName == fileName && c.ConceptStep.LineNo > start && int64(c.ConceptStep.LineNo) <= span.End {
+			span.End, span.EndChar = int64(c.ConceptStep.LineNo), 0
+		}
+	}
+	inliner.diffs[fileName] = append(inliner.diffs[fileName], &gauge_messages.TextDiff{Span: span, Content: ""})
+	return nil
+}
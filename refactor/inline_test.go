@@ -0,0 +1,159 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package refactor
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+const inlineSpec = `# Spec
+
+   |user|
+   |----|
+   |bob |
+
+## First
+* login as "admin" with
+   |name|
+   |----|
+   |john|
+* logout
+
+## Second
+* login as <user> with "nobody"
+`
+
+const inlineConcept = `# login as <user> with <table>
+* open login page
+* enter <user>
+* create users <table>
+* check
+   |by    |
+   |------|
+   |<user>|
+
+# logout
+* click logout
+* login as "guest" with "none"
+`
+
+func (s *MySuite) TestInlineConceptReplacesUsageWithConceptSteps(c *C) {
+	dir := createConceptProject(c, inlineSpec, inlineConcept)
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := GetInlineConceptChanges("login as <user> with <table>", filepath.Join(dir, "specs", "example.spec"), 8, false, []string{"specs"})
+
+	c.Assert(res.Errors, HasLen, 0)
+	c.Assert(res.Success, Equals, true)
+	c.Assert(res.ConceptsChanged, HasLen, 0)
+	c.Assert(res.SpecsChanged, HasLen, 1)
+	c.Assert(res.SpecsChanged[0].FileContent, Equals, `# Spec
+
+   |user|
+   |----|
+   |bob |
+
+## First
+* open login page
+* enter "admin"
+* create users 
+
+   |name|
+   |----|
+   |john|
+* check 
+
+   |by   |
+   |-----|
+   |admin|
+* logout
+
+## Second
+* login as <user> with "nobody"
+`)
+	span := res.SpecsChanged[0].Diffs[0].Span
+	c.Assert([]int64{span.Start, span.StartChar, span.End, span.EndChar}, DeepEquals, []int64{8, 0, 11, 9})
+}
+
+func (s *MySuite) TestInlineConceptReplacesEveryUsageAndRemovesDefinition(c *C) {
+	dir := createConceptProject(c, inlineSpec, inlineConcept)
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := GetInlineConceptChanges("login as \"admin\" with <table>", "", 0, true, []string{"specs"})
+
+	c.Assert(res.Errors, HasLen, 0)
+	c.Assert(res.Success, Equals, true)
+	c.Assert(res.SpecsChanged, HasLen, 1)
+	c.Assert(res.SpecsChanged[0].Diffs, HasLen, 2)
+	c.Assert(res.SpecsChanged[0].Diffs[1].Content, Equals, `* open login page
+* enter <user>
+* create users "nobody"
+* check 
+
+   |by    |
+   |------|
+   |<user>|`)
+	c.Assert(res.ConceptsChanged, HasLen, 1)
+	c.Assert(res.ConceptsChanged[0].FileContent, Equals, `# logout
+* click logout
+* open login page
+* enter "guest"
+* create users "none"
+* check 
+
+   |by   |
+   |-----|
+   |guest|
+`)
+}
+
+func (s *MySuite) TestInlineConceptKeepsDefinitionWithUsagesLeft(c *C) {
+	dir := createConceptProject(c, inlineSpec, inlineConcept)
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := GetInlineConceptChanges("login as <user> with <table>", filepath.Join(dir, "specs", "example.spec"), 15, true, []string{"specs"})
+
+	c.Assert(res.Success, Equals, true)
+	c.Assert(res.Warnings, DeepEquals, []string{"Concept definition is not removed as it is still used in 2 places"})
+	c.Assert(res.ConceptsChanged, HasLen, 0)
+}
+
+func (s *MySuite) TestInlineConceptFailsWithoutUsageAtLocation(c *C) {
+	dir := createConceptProject(c, inlineSpec, inlineConcept)
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	res := GetInlineConceptChanges("logout", filepath.Join(dir, "specs", "example.spec"), 8, false, []string{"specs"})
+
+	c.Assert(res.Success, Equals, false)
+	c.Assert(res.Errors, DeepEquals, []string{"Concept is not used at specs/example.spec:8"})
+}
@@ -0,0 +1,270 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package refactor
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+const tearDownSeparator = "___"
+
+type specText struct {
+	fileName string
+	lines    []string
+	spec     *gauge.Specification
+}
+
+// GetMoveScenarioChanges gives the changes made by moving the scenario spanning the line of the source spec to the end
+// of the target spec, along with its tags and comments. The target spec is created if it does not exist. Contexts and
+// teardown steps of the source spec which the target spec does not have are added to the moved scenario. Such teardown
+// steps become steps of the scenario, so they are skipped if a step before them fails. No file is changed and the
+// runner is not needed.
+func GetMoveScenarioChanges(source string, line int, target string) *refactoringResult {
+	source, _ = filepath.Abs(source)
+	target, _ = filepath.Abs(target)
+	if source == target {
+		return rephraseFailure("Source and target specs are the same")
+	}
+	from, err := readSpecText(source)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	scenario := scenarioAt(from.spec, line)
+	if scenario == nil {
+		return rephraseFailure(fmt.Sprintf("Scenario not found at %s:%d", util.RelPathToProjectRoot(source), line))
+	}
+	if len(from.spec.Scenarios) == 1 {
+		return rephraseFailure(fmt.Sprintf("Cannot move the only scenario of %s. Rename the spec instead", util.RelPathToProjectRoot(source)))
+	}
+	result := &refactoringResult{Success: true, Errors: []string{}, Warnings: []string{}}
+	var to *specText
+	if common.FileExists(target) {
+		if to, err = readSpecText(target); err != nil {
+			return rephraseFailure(err.Error())
+		}
+		for _, s := range to.spec.Scenarios {
+			if strings.EqualFold(s.Heading.Value, scenario.Heading.Value) {
+				return rephraseFailure(fmt.Sprintf("Scenario '%s' is already present in %s", s.Heading.Value, util.RelPathToProjectRoot(target)))
+			}
+		}
+		if missing := missingDataTableHeaders(from.spec, to.spec, scenario); len(missing) > 0 {
+			return rephraseFailure(fmt.Sprintf("Scenario uses the data table columns %s, which %s does not have", strings.Join(missing, ", "), util.RelPathToProjectRoot(target)))
+		}
+		if len(missingSteps(to.spec.Contexts, from.spec.Contexts)) > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("Contexts of %s will also run for the moved scenario", util.RelPathToProjectRoot(target)))
+		}
+		if teardowns := missingSteps(from.spec.TearDownSteps, to.spec.TearDownSteps); len(teardowns) > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("Teardown steps of %s are added as the last steps of the moved scenario and are skipped if a step fails: %s", util.RelPathToProjectRoot(source), strings.Join(teardowns, ", ")))
+		}
+	} else if missing := missingDataTableHeaders(from.spec, &gauge.Specification{}, scenario); len(missing) > 0 {
+		return rephraseFailure(fmt.Sprintf("Scenario uses the data table columns %s, which %s does not have", strings.Join(missing, ", "), util.RelPathToProjectRoot(target)))
+	}
+
+	start, end := from.scenarioLines(scenario)
+	removal := from.removeLines(start, end)
+	var addition *gauge_messages.TextDiff
+	if to == nil {
+		content := newSpecWith(from.spec, from.lines[start-1:end])
+		addition = &gauge_messages.TextDiff{Span: &gauge_messages.Span{Start: 1, End: 1}, Content: content}
+	} else {
+		addition = to.addScenario(from.movedScenarioLines(scenario, to.spec, start, end))
+	}
+	result.SpecsChanged = []*gauge_messages.FileChanges{
+		{FileName: source, FileContent: applyTextDiffs(strings.Join(from.lines, "\n"), []*gauge_messages.TextDiff{removal}), Diffs: []*gauge_messages.TextDiff{removal}},
+		{FileName: target, FileContent: applyTextDiffs(to.content(), []*gauge_messages.TextDiff{addition}), Diffs: []*gauge_messages.TextDiff{addition}},
+	}
+	if from.spec.Tags != nil && len(from.spec.Tags.Values()) > 0 && to != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("Tags of %s are not added to the moved scenario: %s", util.RelPathToProjectRoot(source), strings.Join(from.spec.Tags.Values(), ", ")))
+	}
+	if scenario.ID() == "" {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("Scenario '%s' has no ID. Add a `<!-- scenario-id: <id> -->` comment to it to rerun it with --failed after moving", scenario.Heading.Value))
+	}
+	return result
+}
+
+// MoveScenario moves the scenario and writes the changes to the specification files.
+func MoveScenario(source string, line int, target string) *refactoringResult {
+	result := GetMoveScenarioChanges(source, line, target)
+	if result.Success {
+		writeFileChangesToDisk(result)
+	}
+	return result
+}
+
+// RefactorMoveScenario moves the scenario and prints the refactoring summary.
+func RefactorMoveScenario(source string, line int, target string) {
+	printRefactoringSummary(MoveScenario(source, line, target))
+}
+
+// PreviewMoveScenario prints the changes moving the scenario would make as a unified diff, or as JSON if machineReadable
+// is set, without changing any file.
+func PreviewMoveScenario(source string, line int, target string, machineReadable bool) {
+	printRefactoringPreview(previewOf(GetMoveScenarioChanges(source, line, target)), machineReadable)
+}
+
+func readSpecText(fileName string) (*specText, error) {
+	content, err := common.ReadFileContents(fileName)
+	if err != nil {
+		return nil, err
+	}
+	spec, res, err := new(parser.SpecParser).Parse(content, gauge.NewConceptDictionary(), fileName)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Ok {
+		return nil, fmt.Errorf("Cannot move the scenario due to parse errors in %s:\n%s", util.RelPathToProjectRoot(fileName), strings.Join(res.Errors(), "\n"))
+	}
+	return &specText{fileName: fileName, lines: strings.Split(content, "\n"), spec: spec}, nil
+}
+
+func (t *specText) content() string {
+	if t == nil {
+		return ""
+	}
+	return strings.Join(t.lines, "\n")
+}
+
+func scenarioAt(spec *gauge.Specification, line int) *gauge.Scenario {
+	for _, scenario := range spec.Scenarios {
+		if scenario.InSpan(line) && (spec.TearDownSteps == nil || line < tearDownLine(spec)) {
+			return scenario
+		}
+	}
+	return nil
+}
+
+func tearDownLine(spec *gauge.Specification) int {
+	for _, item := range spec.Items {
+		if item.Kind() == gauge.TearDownKind {
+			return item.(*gauge.TearDown).LineNo
+		}
+	}
+	return 0
+}
+
+// scenarioLines gives the first and last line of the scenario, excluding the blank lines after it.
+func (t *specText) scenarioLines(scenario *gauge.Scenario) (int, int) {
+	start := scenario.Heading.LineNo
+	end := len(t.lines)
+	if l := tearDownLine(t.spec); l > start {
+		end = l - 1
+	}
+	for _, s := range t.spec.Scenarios {
+		if s.Heading.LineNo > start && s.Heading.LineNo-1 < end {
+			end = s.Heading.LineNo - 1
+		}
+	}
+	for end > start && strings.TrimSpace(t.lines[end-1]) == "" {
+		end--
+	}
+	return start, end
+}
+
+// removeLines gives the diff which removes the lines along with the blank lines after them. If only blank lines follow,
+// the blank lines before them are removed instead.
+func (t *specText) removeLines(start, end int) *gauge_messages.TextDiff {
+	next := end + 1
+	for next <= len(t.lines) && strings.TrimSpace(t.lines[next-1]) == "" {
+		next++
+	}
+	if next <= len(t.lines) {
+		return &gauge_messages.TextDiff{Span: &gauge_messages.Span{Start: int64(start), End: int64(next)}, Content: ""}
+	}
+	prev := start - 1
+	for prev > 1 && strings.TrimSpace(t.lines[prev-1]) == "" {
+		prev--
+	}
+	return &gauge_messages.TextDiff{Span: &gauge_messages.Span{Start: int64(prev), StartChar: int64(len(t.lines[prev-1])), End: int64(end), EndChar: int64(len(t.lines[end-1]))}, Content: ""}
+}
+
+// movedScenarioLines gives the lines of the scenario, with the contexts and teardown steps of the spec that the target spec does not have.
+func (t *specText) movedScenarioLines(scenario *gauge.Scenario, target *gauge.Specification, start, end int) []string {
+	lines := append([]string{}, t.lines[start-1:end]...)
+	if contexts := missingSteps(t.spec.Contexts, target.Contexts); len(contexts) > 0 {
+		at := len(lines)
+		if len(scenario.Steps) > 0 {
+			at = scenario.Steps[0].LineNo - start
+		}
+		lines = append(lines[:at], append(contexts, lines[at:]...)...)
+	}
+	return append(lines, missingSteps(t.spec.TearDownSteps, target.TearDownSteps)...)
+}
+
+// addScenario gives the diff which adds the scenario lines after the last scenario of the spec, before the teardown steps.
+func (t *specText) addScenario(lines []string) *gauge_messages.TextDiff {
+	at := len(t.lines)
+	if l := tearDownLine(t.spec); l > 0 {
+		at = l - 1
+	}
+	for at > 0 && strings.TrimSpace(t.lines[at-1]) == "" {
+		at--
+	}
+	content := "\n\n" + strings.Join(lines, "\n")
+	return &gauge_messages.TextDiff{Span: &gauge_messages.Span{Start: int64(at), StartChar: int64(len(t.lines[at-1])), End: int64(at), EndChar: int64(len(t.lines[at-1]))}, Content: content}
+}
+
+func newSpecWith(spec *gauge.Specification, scenarioLines []string) string {
+	content := formatter.FormatHeading(spec.Heading.Value, "#") + "\n"
+	if spec.Tags != nil && len(spec.Tags.Values()) > 0 {
+		content += "Tags: " + strings.Join(spec.Tags.Values(), ", ") + "\n\n"
+	}
+	if contexts := missingSteps(spec.Contexts, nil); len(contexts) > 0 {
+		content += strings.Join(contexts, "\n") + "\n\n"
+	}
+	content += strings.Join(scenarioLines, "\n") + "\n"
+	if teardowns := missingSteps(spec.TearDownSteps, nil); len(teardowns) > 0 {
+		content += "\n" + tearDownSeparator + "\n" + strings.Join(teardowns, "\n") + "\n"
+	}
+	return content
+}
+
+// missingSteps gives the formatted steps which are not present in the other steps.
+func missingSteps(steps, others []*gauge.Step) []string {
+	present := make(map[string]bool)
+	for _, step := range others {
+		present[strings.TrimRight(formatter.FormatStep(step), "\n")] = true
+	}
+	var missing []string
+	for _, step := range steps {
+		if text := strings.TrimRight(formatter.FormatStep(step), "\n"); !present[text] {
+			missing = append(missing, text)
+		}
+	}
+	return missing
+}
+
+func missingDataTableHeaders(from, to *gauge.Specification, scenario *gauge.Scenario) []string {
+	if !from.DataTable.IsInitialized() {
+		return nil
+	}
+	var missing []string
+	for _, header := range from.DataTable.Table.Headers {
+		if scenario.UsesArgsInSteps(header) && !(to.DataTable.IsInitialized() && util.ListContains(to.DataTable.Table.Headers, header)) {
+			missing = append(missing, header)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,117 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func createMoveProject(c *C, specs map[string]string) string {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	c.Assert(os.Mkdir(filepath.Join(dir, "specs"), 0755), IsNil)
+	for name, content := range specs {
+		c.Assert(ioutil.WriteFile(filepath.Join(dir, "specs", name), []byte(content), 0644), IsNil)
+	}
+	return dir
+}
+
+func (s *MySuite) TestMoveScenarioToExistingSpecAddsMissingContextsAndTeardowns(c *C) {
+	dir := createMoveProject(c, map[string]string{
+		"login.spec": "# Login\n* open browser\n\n## Valid login\n<!-- scenario-id: valid-login -->\n* login as \"admin\"\n\n## Invalid login\n* login as \"nobody\"\n\n___\n* close browser\n",
+		"admin.spec": "# Admin\n* open browser\n\n## Add user\n* add user \"bob\"\n",
+	})
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	source := filepath.Join(dir, "specs", "login.spec")
+	target := filepath.Join(dir, "specs", "admin.spec")
+
+	result := GetMoveScenarioChanges(source, 6, target)
+
+	c.Assert(result.Errors, DeepEquals, []string{})
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.Warnings, DeepEquals, []string{"Teardown steps of " + filepath.Join("specs", "login.spec") + " are added as the last steps of the moved scenario and are skipped if a step fails: * close browser"})
+	c.Assert(len(result.SpecsChanged), Equals, 2)
+	c.Assert(fileChangeFor(result.SpecsChanged, "login.spec").FileContent, Equals,
+		"# Login\n* open browser\n\n## Invalid login\n* login as \"nobody\"\n\n___\n* close browser\n")
+	c.Assert(fileChangeFor(result.SpecsChanged, "admin.spec").FileContent, Equals,
+		"# Admin\n* open browser\n\n## Add user\n* add user \"bob\"\n\n## Valid login\n<!-- scenario-id: valid-login -->\n* login as \"admin\"\n* close browser\n")
+	content, err := ioutil.ReadFile(source)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Not(Equals), fileChangeFor(result.SpecsChanged, "login.spec").FileContent)
+}
+
+func (s *MySuite) TestMoveScenarioToNewSpec(c *C) {
+	dir := createMoveProject(c, map[string]string{
+		"login.spec": "# Login\n\ntags: auth\n\n* open browser\n\n## Valid login\n* login as \"admin\"\n\n## Invalid login\n* login as \"nobody\"\n",
+	})
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	source := filepath.Join(dir, "specs", "login.spec")
+	target := filepath.Join(dir, "specs", "invalid.spec")
+
+	result := MoveScenario(source, 10, target)
+
+	c.Assert(result.Success, Equals, true)
+	content, err := ioutil.ReadFile(target)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, fileChangeFor(result.SpecsChanged, "invalid.spec").FileContent)
+	c.Assert(string(content), Matches, "(?s)# Login.*Tags: auth.*\\* open browser\n\n## Invalid login\n\\* login as \"nobody\".*")
+	content, err = ioutil.ReadFile(source)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Not(Matches), "(?s).*Invalid login.*")
+}
+
+func (s *MySuite) TestMoveOnlyScenarioFails(c *C) {
+	dir := createMoveProject(c, map[string]string{
+		"login.spec": "# Login\n## Valid login\n* login as \"admin\"\n",
+	})
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	result := GetMoveScenarioChanges(filepath.Join(dir, "specs", "login.spec"), 3, filepath.Join(dir, "specs", "other.spec"))
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors, DeepEquals, []string{"Cannot move the only scenario of " + filepath.Join("specs", "login.spec") + ". Rename the spec instead"})
+}
+
+func (s *MySuite) TestMoveScenarioFailsIfTargetHasScenarioWithSameHeading(c *C) {
+	dir := createMoveProject(c, map[string]string{
+		"login.spec": "# Login\n## Valid login\n* login as \"admin\"\n\n## Invalid login\n* login as \"nobody\"\n",
+		"admin.spec": "# Admin\n## valid login\n* login as \"root\"\n",
+	})
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+
+	result := GetMoveScenarioChanges(filepath.Join(dir, "specs", "login.spec"), 2, filepath.Join(dir, "specs", "admin.spec"))
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors, DeepEquals, []string{"Scenario 'valid login' is already present in " + filepath.Join("specs", "admin.spec")})
+}
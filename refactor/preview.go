@@ -0,0 +1,158 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+)
+
+const diffContext = 3
+
+// FileDiff is the unified diff of the changes to a file.
+type FileDiff struct {
+	FileName string `json:"fileName"`
+	Diff     string `json:"diff"`
+}
+
+// RefactoringPreview holds the changes a refactoring would make, along with its errors and warnings.
+type RefactoringPreview struct {
+	Success  bool       `json:"success"`
+	Errors   []string   `json:"errors"`
+	Warnings []string   `json:"warnings"`
+	Changes  []FileDiff `json:"changes"`
+}
+
+// PreviewRefactoring gives the changes that rephrase refactoring would make to the specification, concept and
+// implementation files as unified diffs. No file is changed.
+func PreviewRefactoring(oldStep, newStep string, startChan *runner.StartChannels, specDirs []string) *RefactoringPreview {
+	defer killRunner(startChan)
+	var r runner.Runner
+	select {
+	case r = <-startChan.RunnerChan:
+	case err := <-startChan.ErrorChan:
+		return &RefactoringPreview{Errors: []string{"Cannot perform refactoring: Unable to connect to runner. " + err.Error()}}
+	}
+	return previewOf(GetRefactoringChanges(context.Background(), oldStep, newStep, r, specDirs))
+}
+
+func previewOf(res *refactoringResult) *RefactoringPreview {
+	preview := &RefactoringPreview{Success: res.Success, Errors: res.Errors, Warnings: res.Warnings, Changes: []FileDiff{}}
+	if !res.Success {
+		return preview
+	}
+	for _, fileChange := range append(res.SpecsChanged, append(res.ConceptsChanged, res.RunnerFilesChanged...)...) {
+		d, err := diffFileChange(fileChange)
+		if err != nil {
+			preview.Warnings = append(preview.Warnings, err.Error())
+			continue
+		}
+		preview.Changes = append(preview.Changes, d)
+	}
+	return preview
+}
+
+func diffFileChange(fileChange *gauge_messages.FileChanges) (FileDiff, error) {
+	file := util.GetPathToFile(fileChange.FileName)
+	name := util.RelPathToProjectRoot(file)
+	if fileChange.FileContent == "" && len(fileChange.Diffs) == 0 {
+		return FileDiff{}, fmt.Errorf("Changes to %s are not available for preview", name)
+	}
+	oldContent := ""
+	if common.FileExists(file) {
+		var err error
+		if oldContent, err = common.ReadFileContents(file); err != nil {
+			return FileDiff{}, err
+		}
+	}
+	newContent := fileChange.FileContent
+	if newContent == "" {
+		newContent = applyTextDiffs(oldContent, fileChange.Diffs)
+	}
+	return FileDiff{FileName: name, Diff: util.UnifiedDiff("a/"+name, "b/"+name, oldContent, newContent, diffContext)}, nil
+}
+
+// applyTextDiffs replaces the spans of the content with the text of the diffs. Lines of a span start at 1 and characters at 0.
+func applyTextDiffs(content string, diffs []*gauge_messages.TextDiff) string {
+	lines := strings.SplitAfter(content, "\n")
+	offset := func(line, char int64) int {
+		o := 0
+		for i := 0; i < int(line)-1 && i < len(lines); i++ {
+			o += len(lines[i])
+		}
+		if line >= 1 && int(line) <= len(lines) {
+			runes := []rune(strings.TrimSuffix(lines[line-1], "\n"))
+			if int(char) > len(runes) {
+				char = int64(len(runes))
+			}
+			o += len(string(runes[:char]))
+		}
+		return o
+	}
+	sorted := append([]*gauge_messages.TextDiff(nil), diffs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].GetSpan(), sorted[j].GetSpan()
+		return a.GetStart() > b.GetStart() || (a.GetStart() == b.GetStart() && a.GetStartChar() > b.GetStartChar())
+	})
+	for _, d := range sorted {
+		start := offset(d.GetSpan().GetStart(), d.GetSpan().GetStartChar())
+		end := offset(d.GetSpan().GetEnd(), d.GetSpan().GetEndChar())
+		content = content[:start] + d.GetContent() + content[end:]
+	}
+	return content
+}
+
+func printRefactoringPreview(preview *RefactoringPreview, machineReadable bool) {
+	exitCode := 0
+	if !preview.Success {
+		exitCode = 1
+	}
+	if machineReadable {
+		b, err := json.Marshal(preview)
+		if err != nil {
+			logger.Fatalf(true, "Failed to convert refactoring preview to JSON. Reason: %s", err.Error())
+		}
+		fmt.Println(string(b))
+		os.Exit(exitCode)
+	}
+	for _, err := range preview.Errors {
+		logger.Errorf(true, "%s \n", err)
+	}
+	for _, warning := range preview.Warnings {
+		logger.Warningf(true, "%s \n", warning)
+	}
+	for _, change := range preview.Changes {
+		fmt.Print(change.Diff)
+	}
+	os.Exit(exitCode)
+}
+
+// PreviewSteps prints the changes rephrase refactoring would make as a unified diff, or as JSON if machineReadable is set, without changing any file.
+func PreviewSteps(oldStep, newStep string, startChan *runner.StartChannels, specDirs []string, machineReadable bool) {
+	printRefactoringPreview(PreviewRefactoring(oldStep, newStep, startChan, specDirs), machineReadable)
+}
@@ -0,0 +1,70 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestApplyTextDiffsReplacesSpans(c *C) {
+	content := "public void hello() {\n}\n@Step(\"say hello\")\npublic void hello(String name) {\n}\n"
+	diffs := []*gauge_messages.TextDiff{
+		{Span: &gauge_messages.Span{Start: 3, StartChar: 7, End: 3, EndChar: 16}, Content: "greet <name>"},
+		{Span: &gauge_messages.Span{Start: 4, StartChar: 12, End: 4, EndChar: 17}, Content: "greet"},
+	}
+
+	got := applyTextDiffs(content, diffs)
+
+	c.Assert(got, Equals, "public void hello() {\n}\n@Step(\"greet <name>\")\npublic void greet(String name) {\n}\n")
+}
+
+func (s *MySuite) TestDiffFileChangeComparesWithFileOnDisk(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	oldRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = oldRoot }()
+	file := filepath.Join(dir, "example.spec")
+	c.Assert(ioutil.WriteFile(file, []byte("# Spec\n## Scenario\n* say hello\n"), 0644), IsNil)
+
+	got, err := diffFileChange(&gauge_messages.FileChanges{FileName: file, FileContent: "# Spec\n## Scenario\n* greet \"world\"\n"})
+
+	c.Assert(err, IsNil)
+	c.Assert(got.FileName, Equals, "example.spec")
+	c.Assert(got.Diff, Equals, `--- a/example.spec
++++ b/example.spec
+@@ -1,3 +1,3 @@
+ # Spec
+ ## Scenario
+-* say hello
++* greet "world"
+`)
+}
+
+func (s *MySuite) TestDiffFileChangeWithoutContentOrDiffsIsNotAvailable(c *C) {
+	_, err := diffFileChange(&gauge_messages.FileChanges{FileName: "StepImplementation.java"})
+
+	c.Assert(err, NotNil)
+}
@@ -28,12 +28,14 @@
 package refactor
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/formatter"
 	"github.com/getgauge/gauge/gauge"
@@ -126,7 +128,7 @@ func PerformRephraseRefactoring(oldStep, newStep string, startChan *runner.Start
 		}
 		return rephraseFailure(messages...)
 	}
-	result, specs, conceptDictionary := parseSpecsAndConcepts(specDirs)
+	result, specs, conceptDictionary := parseSpecsAndConcepts(context.Background(), specDirs)
 	if !result.Success {
 		return result
 	}
@@ -137,8 +139,8 @@ func PerformRephraseRefactoring(oldStep, newStep string, startChan *runner.Start
 }
 
 // GetRefactoringChanges given an old step and new step gives the list of steps that need to be changed to perform refactoring.
-// It also provides the changes to be made on the implementation files.
-func GetRefactoringChanges(oldStep, newStep string, runner runner.Runner, specDirs []string) *refactoringResult {
+// It also provides the changes to be made on the implementation files. Refactoring stops with a failure once the context is done.
+func GetRefactoringChanges(ctx context.Context, oldStep, newStep string, runner runner.Runner, specDirs []string) *refactoringResult {
 	if newStep == oldStep {
 		return &refactoringResult{Success: true}
 	}
@@ -151,17 +153,20 @@ func GetRefactoringChanges(oldStep, newStep string, runner runner.Runner, specDi
 		}
 		return rephraseFailure(messages...)
 	}
-	result, specs, conceptDictionary := parseSpecsAndConcepts(specDirs)
+	result, specs, conceptDictionary := parseSpecsAndConcepts(ctx, specDirs)
 	if !result.Success {
 		return result
 	}
+	if err := ctx.Err(); err != nil {
+		return rephraseFailure(err.Error())
+	}
 
 	refactorResult := agent.getRefactoringChangesFor(specs, conceptDictionary)
 	refactorResult.Warnings = append(refactorResult.Warnings, result.Warnings...)
 	return refactorResult
 }
 
-func parseSpecsAndConcepts(specDirs []string) (*refactoringResult, []*gauge.Specification, *gauge.ConceptDictionary) {
+func parseSpecsAndConcepts(ctx context.Context, specDirs []string) (*refactoringResult, []*gauge.Specification, *gauge.ConceptDictionary) {
 	result := &refactoringResult{Success: true, Errors: make([]string, 0), Warnings: make([]string, 0)}
 
 	var specs []*gauge.Specification
@@ -169,7 +174,10 @@ func parseSpecsAndConcepts(specDirs []string) (*refactoringResult, []*gauge.Spec
 
 	for _, dir := range specDirs {
 		specFiles := util.GetSpecFiles([]string{filepath.Join(config.ProjectRoot, dir)})
-		specSlice, specParseResultsSlice := parser.ParseSpecFiles(specFiles, &gauge.ConceptDictionary{}, gauge.NewBuildErrors())
+		specSlice, specParseResultsSlice, err := parser.ParseSpecFilesContext(ctx, specFiles, &gauge.ConceptDictionary{}, gauge.NewBuildErrors())
+		if err != nil {
+			return rephraseFailure(err.Error()), nil, nil
+		}
 		specs = append(specs, specSlice...)
 		specParseResults = append(specParseResults, specParseResultsSlice...)
 	}
@@ -228,7 +236,7 @@ func (agent *rephraseRefactorer) performRefactoringOn(specs []*gauge.Specificati
 
 func writeFileChangesToDisk(result *refactoringResult) {
 	for _, fileChange := range result.SpecsChanged {
-		util.SaveFile(fileChange.FileName, fileChange.FileContent, true)
+		util.SaveFile(fileChange.FileName, fileChange.FileContent, common.FileExists(fileChange.FileName))
 	}
 	for _, fileChange := range result.ConceptsChanged {
 		util.SaveFile(fileChange.FileName, fileChange.FileContent, true)
@@ -86,6 +86,10 @@ func prepStacktrace(stacktrace string) string {
 	return fmt.Sprintf("Stacktrace: \n%s", stacktrace)
 }
 
+func prepStepOutput(out string) string {
+	return fmt.Sprintf("Output: \n%s", strings.TrimRight(out, "\r\n"))
+}
+
 func formatErrorFragment(fragment string, indentation int) string {
 	return indent(fragment, indentation+errorIndentation) + newline
 }
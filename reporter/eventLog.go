@@ -0,0 +1,359 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+// EventLogSchemaVersion is the version of the event log format. It is incremented whenever
+// a field is removed or its meaning changes, so that consumers can detect incompatible logs.
+const EventLogSchemaVersion = 1
+
+const (
+	conceptStart eventType = "conceptStart"
+	conceptEnd   eventType = "conceptEnd"
+	stepStart    eventType = "stepStart"
+	stepEnd      eventType = "stepEnd"
+	out          eventType = "out"
+)
+
+// EventLogFile is the file to which every execution event is written as newline delimited JSON.
+// No event log is written if it is empty.
+var EventLogFile string
+
+type loggedEvent struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Timestamp     string `json:"timestamp"`
+	Stream        int    `json:"stream"`
+	executionEvent
+	Message string `json:"message,omitempty"`
+}
+
+type frame struct {
+	id         string
+	name       string
+	filename   string
+	line       int
+	scenarioID string
+}
+
+type eventLog struct {
+	*sync.Mutex
+	writer     io.Writer
+	isParallel bool
+	frames     map[int][]*frame
+}
+
+var currentEventLog = newEventLog(nil, false)
+
+func newEventLog(w io.Writer, isParallel bool) *eventLog {
+	return &eventLog{Mutex: &sync.Mutex{}, writer: w, isParallel: isParallel, frames: make(map[int][]*frame)}
+}
+
+// ListenAndLogExecutionEvents writes all execution events and the runner output to EventLogFile.
+func ListenAndLogExecutionEvents(wg *sync.WaitGroup) {
+	f, err := os.Create(EventLogFile)
+	if err != nil {
+		logger.Fatalf(true, "Failed to create event log %s. Reason: %s", EventLogFile, err.Error())
+	}
+	currentEventLog.start(f, IsParallel)
+	ch := make(chan event.ExecutionEvent, 0)
+	event.Register(ch, event.SuiteStart, event.SpecStart, event.SpecEnd, event.ScenarioStart, event.ScenarioEnd, event.StepStart, event.StepEnd, event.ConceptStart, event.ConceptEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		defer recoverPanic()
+		for {
+			e := <-ch
+			currentEventLog.log(e)
+			if e.Topic == event.SuiteEnd {
+				currentEventLog.stop()
+				if err := f.Close(); err != nil {
+					logger.Errorf(true, "Failed to write event log %s. Reason: %s", EventLogFile, err.Error())
+				}
+				wg.Done()
+			}
+		}
+	}()
+}
+
+func (l *eventLog) start(w io.Writer, isParallel bool) {
+	l.Lock()
+	defer l.Unlock()
+	l.writer = w
+	l.isParallel = isParallel
+	l.frames = make(map[int][]*frame)
+}
+
+func (l *eventLog) stop() {
+	l.Lock()
+	defer l.Unlock()
+	l.writer = nil
+}
+
+func (l *eventLog) out(stream int, text string) {
+	l.Lock()
+	defer l.Unlock()
+	text = strings.TrimRight(text, "\r\n")
+	if l.writer == nil || text == "" {
+		return
+	}
+	now := time.Now()
+	for _, line := range strings.Split(text, "\n") {
+		l.write(now, stream, executionEvent{EventType: out, ParentID: l.parentID(stream)}, strings.TrimRight(line, "\r"))
+	}
+}
+
+func (l *eventLog) log(e event.ExecutionEvent) {
+	l.Lock()
+	defer l.Unlock()
+	if l.writer == nil {
+		return
+	}
+	l.write(e.Timestamp, e.Stream, l.executionEvent(e), "")
+}
+
+func (l *eventLog) executionEvent(e event.ExecutionEvent) executionEvent {
+	switch e.Topic {
+	case event.SuiteStart:
+		return executionEvent{EventType: suiteStart}
+	case event.SpecStart:
+		spec := e.Item.(*gauge.Specification)
+		return executionEvent{
+			EventType: specStart,
+			ID:        getIDWithRow(spec.FileName, spec.Scenarios, l.isParallel && spec.DataTable.IsInitialized()),
+			Name:      spec.Heading.Value,
+			Filename:  spec.FileName,
+			Line:      spec.Heading.LineNo,
+		}
+	case event.ScenarioStart:
+		f := l.push(e.Stream, scenarioFrame(e.Item.(*gauge.Scenario), e.ExecutionInfo, l.isParallel))
+		return executionEvent{
+			EventType:  scenarioStart,
+			ID:         f.id,
+			ParentID:   f.parentID(),
+			Name:       f.name,
+			Filename:   f.filename,
+			Line:       f.line,
+			ScenarioID: f.scenarioID,
+			Res:        &executionResult{Table: getTable(e.Item.(*gauge.Scenario))},
+		}
+	case event.ConceptStart, event.StepStart:
+		step := e.Item.(*gauge.Step)
+		name := formatter.FormatStep(step)
+		t := conceptStart
+		if e.Topic == event.StepStart {
+			name = formatter.FormatStepWithResolvedArgs(step)
+			t = stepStart
+		}
+		parentID := l.parentID(e.Stream)
+		f := l.push(e.Stream, l.stepFrame(e.Stream, step, name, e.ExecutionInfo))
+		return executionEvent{EventType: t, ID: f.id, ParentID: parentID, Name: f.name, Filename: f.filename, Line: f.line}
+	case event.StepEnd:
+		f := l.pop(e.Stream)
+		return executionEvent{EventType: stepEnd, ID: f.id, ParentID: l.parentID(e.Stream), Name: f.name, Filename: f.filename, Line: f.line, Res: stepExecutionResult(e.Item.(gauge.Step), e.Result.(*result.StepResult))}
+	case event.ConceptEnd:
+		f := l.pop(e.Stream)
+		return executionEvent{
+			EventType: conceptEnd,
+			ID:        f.id,
+			ParentID:  l.parentID(e.Stream),
+			Name:      f.name,
+			Filename:  f.filename,
+			Line:      f.line,
+			Res:       &executionResult{Status: getStatus(e.Result.GetFailed(), false), Time: e.Result.ExecTime()},
+		}
+	case event.ScenarioEnd:
+		f := l.pop(e.Stream)
+		res := e.Result.(*result.ScenarioResult)
+		return executionEvent{
+			EventType:  scenarioEnd,
+			ID:         f.id,
+			ParentID:   f.parentID(),
+			Name:       f.name,
+			Filename:   f.filename,
+			Line:       f.line,
+			ScenarioID: f.scenarioID,
+			Res: &executionResult{
+				Status:            getScenarioStatus(res),
+				Time:              res.ExecTime(),
+				Errors:            skipErrors(f.name, f.filename, res.ProtoScenario.GetSkipErrors()),
+				BeforeHookFailure: getHookFailure(res.GetPreHook(), "Before Scenario"),
+				AfterHookFailure:  getHookFailure(res.GetPostHook(), "After Scenario"),
+				Table:             getTable(e.Item.(*gauge.Scenario)),
+			},
+		}
+	case event.SpecEnd:
+		spec := e.Item.(*gauge.Specification)
+		res := e.Result.(*result.SpecResult)
+		return executionEvent{
+			EventType: specEnd,
+			ID:        getIDWithRow(spec.FileName, spec.Scenarios, l.isParallel && spec.DataTable.IsInitialized()),
+			Name:      spec.Heading.Value,
+			Filename:  spec.FileName,
+			Line:      spec.Heading.LineNo,
+			Res: &executionResult{
+				Status:            getStatus(res.GetFailed(), res.Skipped),
+				Time:              res.ExecTime(),
+				BeforeHookFailure: getHookFailure(res.GetPreHook(), "Before Specification"),
+				AfterHookFailure:  getHookFailure(res.GetPostHook(), "After Specification"),
+			},
+		}
+	case event.SuiteEnd:
+		res := e.Result.(*result.SuiteResult)
+		return executionEvent{
+			EventType: suiteEnd,
+			Res: &executionResult{
+				Status:            getStatus(res.IsFailed, false),
+				Time:              res.ExecutionTime,
+				BeforeHookFailure: getHookFailure(res.GetPreHook(), "Before Suite"),
+				AfterHookFailure:  getHookFailure(res.GetPostHook(), "After Suite"),
+			},
+		}
+	}
+	return executionEvent{}
+}
+
+func (l *eventLog) write(t time.Time, stream int, e executionEvent, message string) {
+	b, err := json.Marshal(loggedEvent{
+		SchemaVersion:  EventLogSchemaVersion,
+		Timestamp:      t.Format(time.RFC3339Nano),
+		Stream:         stream,
+		executionEvent: e,
+		Message:        message,
+	})
+	if err != nil {
+		logger.Errorf(false, "Failed to write execution event to event log. Reason: %s", err.Error())
+		return
+	}
+	fmt.Fprint(l.writer, string(b)+newline)
+}
+
+func (l *eventLog) push(stream int, f *frame) *frame {
+	l.frames[stream] = append(l.frames[stream], f)
+	return f
+}
+
+func (l *eventLog) pop(stream int) *frame {
+	f := l.top(stream)
+	if f == nil {
+		return &frame{}
+	}
+	l.frames[stream] = l.frames[stream][:len(l.frames[stream])-1]
+	return f
+}
+
+func (l *eventLog) top(stream int) *frame {
+	frames := l.frames[stream]
+	if len(frames) == 0 {
+		return nil
+	}
+	return frames[len(frames)-1]
+}
+
+func (l *eventLog) parentID(stream int) string {
+	if f := l.top(stream); f != nil {
+		return f.id
+	}
+	return ""
+}
+
+// stepFrame identifies a step or concept by its line, nested under the scenario or concept
+// being executed, so that steps of a concept used more than once get distinct ids.
+func (l *eventLog) stepFrame(stream int, step *gauge.Step, name string, i gm.ExecutionInfo) *frame {
+	filename := step.FileName
+	if filename == "" {
+		filename = i.GetCurrentSpec().GetFileName()
+	}
+	return &frame{
+		id:       l.parentID(stream) + ":" + strconv.Itoa(step.LineNo),
+		name:     strings.TrimSpace(strings.TrimPrefix(name, "*")),
+		filename: filename,
+		line:     step.LineNo,
+	}
+}
+
+func scenarioFrame(scenario *gauge.Scenario, i gm.ExecutionInfo, isParallel bool) *frame {
+	specID := getIDWithRow(i.GetCurrentSpec().GetFileName(), []*gauge.Scenario{scenario}, isParallel && scenario.SpecDataTableRow.IsInitialized())
+	return &frame{
+		id:         specID + ":" + strconv.Itoa(scenario.Span.Start),
+		name:       scenario.Heading.Value,
+		filename:   i.GetCurrentSpec().GetFileName(),
+		line:       scenario.Heading.LineNo,
+		scenarioID: scenario.ID(),
+	}
+}
+
+// parentID of a scenario is the id of its specification, which precedes the scenario's span start.
+func (f *frame) parentID() string {
+	if i := strings.LastIndex(f.id, ":"); i >= 0 {
+		return f.id[:i]
+	}
+	return ""
+}
+
+func stepExecutionResult(step gauge.Step, res *result.StepResult) *executionResult {
+	stepRes := res.ProtoStep.GetStepExecutionResult()
+	execRes := stepRes.GetExecutionResult()
+	r := &executionResult{
+		Status: getStatus(execRes.GetFailed(), stepRes.GetSkipped()),
+		Time:   execRes.GetExecutionTime(),
+		Stdout: res.GetStdout(),
+	}
+	if stepRes.GetSkipped() {
+		r.Errors = []executionError{{Text: step.LineText, Filename: step.FileName, LineNo: strconv.Itoa(step.LineNo), Message: stepRes.GetSkippedReason()}}
+	} else if res.GetStepFailed() {
+		r.Errors = []executionError{{
+			Text:       step.LineText,
+			Filename:   step.FileName,
+			LineNo:     strconv.Itoa(step.LineNo),
+			Message:    execRes.GetErrorMessage(),
+			StackTrace: execRes.GetStackTrace(),
+		}}
+	}
+	if h := stepRes.GetPreHookFailure(); h != nil {
+		r.BeforeHookFailure = getHookFailure([]*gm.ProtoHookFailure{h}, "Before Step")
+	}
+	if h := stepRes.GetPostHookFailure(); h != nil {
+		r.AfterHookFailure = getHookFailure([]*gm.ProtoHookFailure{h}, "After Step")
+	}
+	return r
+}
+
+func skipErrors(text, filename string, errs []string) []executionError {
+	var errors []executionError
+	for _, err := range errs {
+		errors = append(errors, executionError{Text: text, Filename: filename, Message: err})
+	}
+	return errors
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"time"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+var eventLogTime = time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newLoggedEvent(t event.Topic, i gauge.Item, r result.Result) event.ExecutionEvent {
+	info := gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{FileName: "file.spec"}}
+	e := event.NewExecutionEvent(t, i, r, 1, info)
+	e.Timestamp = eventLogTime
+	return e
+}
+
+func (s *MySuite) TestEventLogNestsStepsAndOutputUnderScenario(c *C) {
+	dw := newDummyWriter()
+	l := newEventLog(dw, false)
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "Scenario", LineNo: 2},
+		Span:    &gauge.Span{Start: 2, End: 4},
+	}
+	step := &gauge.Step{Value: "say hello", LineText: "say hello", LineNo: 3, FileName: "file.spec"}
+	stepRes := result.NewStepResult(&gauge_messages.ProtoStep{
+		StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{
+			ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "oops", ExecutionTime: 10},
+		},
+	})
+	stepRes.SetStepFailure()
+
+	l.log(newLoggedEvent(event.ScenarioStart, scenario, &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{}}))
+	l.log(newLoggedEvent(event.StepStart, step, nil))
+	l.write(eventLogTime, 1, executionEvent{EventType: out, ParentID: l.parentID(1)}, "hello")
+	l.log(newLoggedEvent(event.StepEnd, *step, stepRes))
+
+	expected := `{"schemaVersion":1,"timestamp":"2018-01-02T03:04:05Z","stream":1,"type":"scenarioStart","id":"file.spec:2","parentId":"file.spec","name":"Scenario","filename":"file.spec","line":2,"result":{"time":0}}
+{"schemaVersion":1,"timestamp":"2018-01-02T03:04:05Z","stream":1,"type":"stepStart","id":"file.spec:2:3","parentId":"file.spec:2","name":"say hello","filename":"file.spec","line":3}
+{"schemaVersion":1,"timestamp":"2018-01-02T03:04:05Z","stream":1,"type":"out","parentId":"file.spec:2:3","message":"hello"}
+{"schemaVersion":1,"timestamp":"2018-01-02T03:04:05Z","stream":1,"type":"stepEnd","id":"file.spec:2:3","parentId":"file.spec:2","name":"say hello","filename":"file.spec","line":3,"result":{"status":"fail","time":10,"errors":[{"text":"say hello","filename":"file.spec","message":"oops","lineNo":"3","stackTrace":""}]}}
+`
+	c.Assert(dw.output, Equals, expected)
+}
+
+func (s *MySuite) TestEventLogIdentifiesConceptStepsByConcept(c *C) {
+	dw := newDummyWriter()
+	l := newEventLog(dw, false)
+	l.push(1, &frame{id: "file.spec:2"})
+	concept := &gauge.Step{Value: "a concept", LineText: "a concept", LineNo: 3, IsConcept: true}
+
+	l.log(newLoggedEvent(event.ConceptStart, concept, nil))
+	l.log(newLoggedEvent(event.StepStart, &gauge.Step{Value: "a step", LineNo: 2, FileName: "file.cpt"}, nil))
+
+	c.Assert(l.top(1).id, Equals, "file.spec:2:3:2")
+	c.Assert(l.top(1).filename, Equals, "file.cpt")
+
+	l.pop(1)
+	l.log(newLoggedEvent(event.ConceptEnd, nil, result.NewConceptResult(&gauge_messages.ProtoConcept{})))
+
+	c.Assert(dw.output, Matches, `(?s).*"type":"conceptEnd","id":"file.spec:2:3","parentId":"file.spec:2","name":"a concept","filename":"file.spec","line":3,"result":\{"status":"pass","time":0\}.*`)
+}
+
+func (s *MySuite) TestEventLogIgnoresRunnerOutputWhenNotLogging(c *C) {
+	l := newEventLog(nil, false)
+
+	l.out(0, "hello\n")
+
+	c.Assert(l.writer, IsNil)
+}
+
+func (s *MySuite) TestEventLogSplitsRunnerOutputIntoLines(c *C) {
+	dw := newDummyWriter()
+	l := newEventLog(dw, false)
+
+	l.out(2, "hello\r\nworld\n")
+
+	c.Assert(dw.output, Matches, `(?s)[^\n]*"stream":2,"type":"out","message":"hello"}\n[^\n]*"stream":2,"type":"out","message":"world"}\n`)
+}
@@ -59,17 +59,19 @@ type jsonConsole struct {
 type stepInfo struct {
 	step      *gauge.Step
 	protoStep *gm.ProtoStep
+	stdout    string
 }
 
 type executionEvent struct {
-	EventType eventType        `json:"type"`
-	ID        string           `json:"id,omitempty"`
-	ParentID  string           `json:"parentId,omitempty"`
-	Name      string           `json:"name,omitempty"`
-	Filename  string           `json:"filename,omitempty"`
-	Line      int              `json:"line,omitempty"`
-	Stream    int              `json:"stream,omitempty"`
-	Res       *executionResult `json:"result,omitempty"`
+	EventType  eventType        `json:"type"`
+	ID         string           `json:"id,omitempty"`
+	ParentID   string           `json:"parentId,omitempty"`
+	Name       string           `json:"name,omitempty"`
+	Filename   string           `json:"filename,omitempty"`
+	Line       int              `json:"line,omitempty"`
+	ScenarioID string           `json:"scenarioId,omitempty"`
+	Stream     int              `json:"stream,omitempty"`
+	Res        *executionResult `json:"result,omitempty"`
 }
 
 type executionResult struct {
@@ -162,14 +164,15 @@ func (c *jsonConsole) ScenarioStart(scenario *gauge.Scenario, i gm.ExecutionInfo
 	addRow := c.isParallel && scenario.SpecDataTableRow.IsInitialized()
 	parentID := getIDWithRow(i.CurrentSpec.FileName, []*gauge.Scenario{scenario}, addRow)
 	e := executionEvent{
-		EventType: scenarioStart,
-		ID:        parentID + ":" + strconv.Itoa(scenario.Span.Start),
-		ParentID:  parentID,
-		Filename:  i.CurrentSpec.FileName,
-		Line:      scenario.Heading.LineNo,
-		Name:      scenario.Heading.Value,
-		Stream:    c.stream,
-		Res:       &executionResult{Table: getTable(scenario)},
+		EventType:  scenarioStart,
+		ID:         parentID + ":" + strconv.Itoa(scenario.Span.Start),
+		ParentID:   parentID,
+		Filename:   i.CurrentSpec.FileName,
+		Line:       scenario.Heading.LineNo,
+		Name:       scenario.Heading.Value,
+		ScenarioID: scenario.ID(),
+		Stream:     c.stream,
+		Res:        &executionResult{Table: getTable(scenario)},
 	}
 	c.write(e)
 }
@@ -180,16 +183,18 @@ func (c *jsonConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i
 	addRow := c.isParallel && scenario.SpecDataTableRow.IsInitialized()
 	parentID := getIDWithRow(i.CurrentSpec.FileName, []*gauge.Scenario{scenario}, addRow)
 	e := executionEvent{
-		EventType: scenarioEnd,
-		ID:        parentID + ":" + strconv.Itoa(scenario.Span.Start),
-		ParentID:  parentID,
-		Filename:  i.CurrentSpec.FileName,
-		Line:      scenario.Heading.LineNo,
-		Name:      scenario.Heading.Value,
-		Stream:    c.stream,
+		EventType:  scenarioEnd,
+		ID:         parentID + ":" + strconv.Itoa(scenario.Span.Start),
+		ParentID:   parentID,
+		Filename:   i.CurrentSpec.FileName,
+		Line:       scenario.Heading.LineNo,
+		Name:       scenario.Heading.Value,
+		ScenarioID: scenario.ID(),
+		Stream:     c.stream,
 		Res: &executionResult{
 			Status:            getScenarioStatus(res.(*result.ScenarioResult)),
 			Time:              res.ExecTime(),
+			Stdout:            getStdout(c.stepCache[i.CurrentScenario]),
 			Errors:            getErrors(c.stepCache, getAllStepsFromScenario(res.(*result.ScenarioResult).ProtoScenario), i.CurrentSpec.FileName, i),
 			BeforeHookFailure: getHookFailure(res.GetPreHook(), "Before Scenario"),
 			AfterHookFailure:  getHookFailure(res.GetPostHook(), "After Scenario"),
@@ -207,7 +212,8 @@ func (c *jsonConsole) StepStart(stepText string) {
 }
 
 func (c *jsonConsole) StepEnd(step gauge.Step, res result.Result, execInfo gm.ExecutionInfo) {
-	si := &stepInfo{step: &step, protoStep: res.(*result.StepResult).Item().(*gm.ProtoStep)}
+	stepRes := res.(*result.StepResult)
+	si := &stepInfo{step: &step, protoStep: stepRes.Item().(*gm.ProtoStep), stdout: stepRes.GetStdout()}
 	c.stepCache[execInfo.CurrentScenario] = append(c.stepCache[execInfo.CurrentScenario], si)
 }
 
@@ -255,6 +261,14 @@ func getIDWithRow(name string, scenarios []*gauge.Scenario, isDataTable bool) st
 	return name + ":" + strconv.Itoa(scenarios[0].SpecDataTableRowIndex)
 }
 
+func getStdout(steps []*stepInfo) string {
+	var out string
+	for _, si := range steps {
+		out += si.stdout
+	}
+	return out
+}
+
 func getScenarioStatus(result *result.ScenarioResult) status {
 	return getStatus(result.ProtoScenario.GetExecutionStatus() == gm.ExecutionStatus_FAILED,
 		result.ProtoScenario.GetExecutionStatus() == gm.ExecutionStatus_SKIPPED)
@@ -0,0 +1,69 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+var captures = struct {
+	sync.Mutex
+	buffers map[int]*bytes.Buffer
+}{buffers: make(map[int]*bytes.Buffer)}
+
+// RunnerOutput returns the writer to which the output of the runner of the given execution stream is written.
+// The output is also recorded in the event log, if one is being written, and in the capture of the stream, if one is started.
+func RunnerOutput(stream int) io.Writer {
+	return &runnerOutputWriter{stream: stream}
+}
+
+type runnerOutputWriter struct {
+	stream int
+}
+
+func (w *runnerOutputWriter) Write(b []byte) (int, error) {
+	captures.Lock()
+	if buf, ok := captures.buffers[w.stream]; ok {
+		buf.Write(b)
+	}
+	captures.Unlock()
+	currentEventLog.out(w.stream, string(b))
+	return ParallelReporter(w.stream).Write(b)
+}
+
+// StartCapture starts buffering the runner output of the given execution stream, discarding anything captured earlier.
+// Output of a runner shared by several streams is written to stream 0 and so is only captured for stream 0.
+func StartCapture(stream int) {
+	captures.Lock()
+	defer captures.Unlock()
+	captures.buffers[stream] = &bytes.Buffer{}
+}
+
+// StopCapture stops buffering the runner output of the given execution stream and returns the output captured since StartCapture.
+func StopCapture(stream int) string {
+	captures.Lock()
+	defer captures.Unlock()
+	buf, ok := captures.buffers[stream]
+	if !ok {
+		return ""
+	}
+	delete(captures.buffers, stream)
+	return buf.String()
+}
@@ -0,0 +1,47 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestRunnerOutputIsCapturedPerStream(c *C) {
+	currentReporter = newSimpleConsole(newDummyWriter())
+	defer func() { currentReporter = nil }()
+	StartCapture(1)
+	StartCapture(2)
+
+	RunnerOutput(1).Write([]byte("hello\n"))
+	RunnerOutput(2).Write([]byte("world\n"))
+	RunnerOutput(1).Write([]byte("again\n"))
+
+	c.Assert(StopCapture(1), Equals, "hello\nagain\n")
+	c.Assert(StopCapture(2), Equals, "world\n")
+}
+
+func (s *MySuite) TestRunnerOutputIsNotCapturedAfterStopCapture(c *C) {
+	currentReporter = newSimpleConsole(newDummyWriter())
+	defer func() { currentReporter = nil }()
+	StartCapture(0)
+	StopCapture(0)
+
+	RunnerOutput(0).Write([]byte("hello\n"))
+
+	c.Assert(StopCapture(0), Equals, "")
+}
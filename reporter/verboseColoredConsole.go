@@ -113,7 +113,10 @@ func (c *verboseColoredConsole) StepEnd(step gauge.Step, res result.Result, exec
 		c.displayMessage(c.headingBuffer.String()+newline, ct.None)
 	}
 	printHookFailureVCC(c, res, res.GetPreHook)
-	c.displayMessage(c.pluginMessagesBuffer.String(), ct.None)
+	// output of a failing step is shown along with its failure
+	if !stepRes.GetStepFailed() || stepRes.GetStdout() == "" {
+		c.displayMessage(c.pluginMessagesBuffer.String(), ct.None)
+	}
 	c.displayMessage(c.errorMessagesBuffer.String(), ct.Red)
 	if stepRes.GetStepFailed() {
 		stepText := prepStepMsg(step.LineText)
@@ -126,6 +129,11 @@ func (c *verboseColoredConsole) StepEnd(step gauge.Step, res result.Result, exec
 		logger.Error(false, stacktrace)
 
 		msg := formatErrorFragment(stepText, c.indentation) + formatErrorFragment(specInfo, c.indentation) + formatErrorFragment(errMsg, c.indentation) + formatErrorFragment(stacktrace, c.indentation)
+		if stepRes.GetStdout() != "" {
+			output := prepStepOutput(stepRes.GetStdout())
+			logger.Error(false, output)
+			msg += formatErrorFragment(output, c.indentation)
+		}
 
 		c.displayMessage(msg, ct.Red)
 	}
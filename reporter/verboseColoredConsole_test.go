@@ -155,6 +155,33 @@ func (s *MySuite) TestFailingStepEndInVerbose_ColoredConsole(c *C) {
 	c.Assert(dw.output, Equals, cursorUp+eraseLine+"      "+stepText+"\t ...[FAIL]\n"+expectedErrMsg)
 }
 
+func (s *MySuite) TestFailingStepEndWithOutputInVerbose_ColoredConsole(c *C) {
+	dw, cc := setupVerboseColoredConsole()
+	cc.indentation = 2
+	stepText := "* say hello"
+	cc.StepStart(stepText)
+	cc.Write([]byte("hello\n"))
+	dw.output = ""
+	specInfo := gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{FileName: "hello.spec"}}
+	stepExeRes := &gauge_messages.ProtoStepExecutionResult{ExecutionResult: &gauge_messages.ProtoExecutionResult{ErrorMessage: "failure message", StackTrace: "my stacktrace"}}
+	stepRes := result.NewStepResult(&gauge_messages.ProtoStep{StepExecutionResult: stepExeRes})
+	stepRes.SetStepFailure()
+	stepRes.SetStdout("hello\n")
+
+	cc.StepEnd(gauge.Step{LineText: stepText}, stepRes, specInfo)
+
+	expectedErrMsg := `        ` + `
+        Failed Step: * say hello
+        Specification: hello.spec:0
+        Error Message: failure message
+        Stacktrace:` + spaces(1) + `
+        my stacktrace
+        Output:` + spaces(1) + `
+        hello
+`
+	c.Assert(dw.output, Equals, cursorUp+eraseLine+cursorUp+eraseLine+"      "+stepText+"\t ...[FAIL]\n"+expectedErrMsg)
+}
+
 func (s *MySuite) TestStepStartAndStepEnd_ColoredConsole(c *C) {
 	dw, cc := setupVerboseColoredConsole()
 	cc.indentation = 2
@@ -150,10 +150,11 @@ func (w customWriter) Write(p []byte) (n int, err error) {
 	return w.file.Write(p)
 }
 
-// ConnectToGrpcRunner makes a connection with grpc server
-func ConnectToGrpcRunner(manifest *manifest.Manifest, outFile io.Writer, timeout time.Duration) (*GrpcRunner, error) {
+// ConnectToGrpcRunner makes a connection with grpc server. The runner is started with the given env vars of the
+// project, in addition to the environment of gauge.
+func ConnectToGrpcRunner(manifest *manifest.Manifest, outFile io.Writer, timeout time.Duration, projectEnv []string) (*GrpcRunner, error) {
 	portChan := make(chan string)
-	cmd, _, err := runRunnerCommand(manifest, "0", false, customWriter{file: outFile, port: portChan})
+	cmd, _, err := runRunnerCommand(manifest, "0", false, customWriter{file: outFile, port: portChan}, projectEnv)
 	if err != nil {
 		return nil, err
 	}
@@ -313,7 +313,8 @@ func errorResult(message string) *gauge_messages.ProtoExecutionResult {
 	return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: message, RecoverableError: false}
 }
 
-func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, outputStreamWriter io.Writer) (*exec.Cmd, *RunnerInfo, error) {
+// runRunnerCommand starts the runner with the environment of gauge, and the given env vars which take precedence over it.
+func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, outputStreamWriter io.Writer, projectEnv []string) (*exec.Cmd, *RunnerInfo, error) {
 	var r RunnerInfo
 	runnerDir, err := getLanguageJSONFilePath(manifest, &r)
 	if err != nil {
@@ -324,7 +325,7 @@ func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, outp
 		return nil, nil, fmt.Errorf("Compatibility error. %s", compatibilityErr.Error())
 	}
 	command := getOsSpecificCommand(r)
-	env := getCleanEnv(port, os.Environ(), debug, getPluginPaths())
+	env := getCleanEnv(port, append(os.Environ(), projectEnv...), debug, getPluginPaths())
 	env = append(env, fmt.Sprintf("GAUGE_UNIQUE_INSTALLATION_ID=%s", config.UniqueID()))
 	env = append(env, fmt.Sprintf("GAUGE_TELEMETRY_ENABLED=%v", config.TelemetryEnabled()))
 	cmd, err := common.ExecuteCommandWithEnv(command, runnerDir, outputStreamWriter, outputStreamWriter, env)
@@ -334,7 +335,7 @@ func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, outp
 // Looks for a runner configuration inside the runner directory
 // finds the runner configuration matching to the manifest and executes the commands for the current OS
 func StartRunner(manifest *manifest.Manifest, port string, outputStreamWriter io.Writer, killChannel chan bool, debug bool) (*LanguageRunner, error) {
-	cmd, r, err := runRunnerCommand(manifest, port, debug, outputStreamWriter)
+	cmd, r, err := runRunnerCommand(manifest, port, debug, outputStreamWriter, nil)
 	if err != nil {
 		return nil, err
 	}
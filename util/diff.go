@@ -0,0 +1,184 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type editKind int
+
+const (
+	equal editKind = iota
+	deletion
+	insertion
+)
+
+type edit struct {
+	kind editKind
+	text string
+	// number of old and new lines before this edit
+	oldPos, newPos int
+}
+
+// UnifiedDiff returns the changes from oldText to newText in the unified diff format, with the given
+// number of lines of context around every change. It returns an empty string if the texts are the same.
+func UnifiedDiff(oldName, newName, oldText, newText string, context int) string {
+	edits := diffLines(splitLines(oldText), splitLines(newText))
+	var changes []int
+	for i, e := range edits {
+		if e.kind != equal {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", oldName, newName)
+	for i := 0; i < len(changes); {
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j] <= 2*context+1 {
+			j++
+		}
+		start, end := changes[i]-context, changes[j]+context+1
+		if start < 0 {
+			start = 0
+		}
+		if end > len(edits) {
+			end = len(edits)
+		}
+		writeHunk(&buf, edits[start:end])
+		i = j + 1
+	}
+	return buf.String()
+}
+
+func writeHunk(buf *bytes.Buffer, edits []edit) {
+	var oldCount, newCount int
+	for _, e := range edits {
+		if e.kind != insertion {
+			oldCount++
+		}
+		if e.kind != deletion {
+			newCount++
+		}
+	}
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(edits[0].oldPos, oldCount), hunkRange(edits[0].newPos, newCount))
+	for _, e := range edits {
+		switch e.kind {
+		case equal:
+			writeLine(buf, " ", e.text)
+		case deletion:
+			writeLine(buf, "-", e.text)
+		case insertion:
+			writeLine(buf, "+", e.text)
+		}
+	}
+}
+
+// writeLine writes the line with its line ending, and marks a last line without a newline the way diff does.
+func writeLine(buf *bytes.Buffer, prefix, line string) {
+	buf.WriteString(prefix + line)
+	if !strings.HasSuffix(line, "\n") {
+		buf.WriteString("\n\\ No newline at end of file\n")
+	}
+}
+
+func hunkRange(pos, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", pos)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", pos+1)
+	}
+	return fmt.Sprintf("%d,%d", pos+1, count)
+}
+
+// splitLines splits the text into lines which keep their line endings, so that a change of the line endings or of the
+// newline at the end of the text is a change of the lines.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines finds the shortest edit script from a to b using the Myers diff algorithm.
+func diffLines(a, b []string) []edit {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		done := false
+		for k := -d; k <= d && !done; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			done = x >= n && y >= m
+		}
+		if done {
+			break
+		}
+	}
+
+	var edits []edit
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			edits = append(edits, edit{kind: equal, text: a[x-1], oldPos: x - 1, newPos: y - 1})
+			x, y = x-1, y-1
+		}
+		if x == prevX {
+			edits = append(edits, edit{kind: insertion, text: b[y-1], oldPos: x, newPos: y - 1})
+		} else {
+			edits = append(edits, edit{kind: deletion, text: a[x-1], oldPos: x - 1, newPos: y})
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		edits = append(edits, edit{kind: equal, text: a[x-1], oldPos: x - 1, newPos: y - 1})
+		x, y = x-1, y-1
+	}
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
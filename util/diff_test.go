@@ -0,0 +1,96 @@
+// Copyright 2018 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestUnifiedDiffOfSameTextIsEmpty(c *C) {
+	c.Assert(UnifiedDiff("a", "b", "one\ntwo\n", "one\ntwo\n", 3), Equals, "")
+}
+
+func (s *MySuite) TestUnifiedDiffWithContext(c *C) {
+	old := "# Spec\n\n## Scenario\n* say hello\n* say bye\n"
+	new := "# Spec\n\n## Scenario\n* greet \"world\"\n* say bye\n"
+
+	want := `--- a/spec
++++ b/spec
+@@ -2,4 +2,4 @@
+ 
+ ## Scenario
+-* say hello
++* greet "world"
+ * say bye
+`
+	c.Assert(UnifiedDiff("a/spec", "b/spec", old, new, 2), Equals, want)
+}
+
+func (s *MySuite) TestUnifiedDiffSplitsDistantChangesIntoHunks(c *C) {
+	old := "1\n2\n3\n4\n5\n6\n7\n8\n"
+	new := "one\n2\n3\n4\n5\n6\n7\neight\n"
+
+	want := `--- old
++++ new
+@@ -1,2 +1,2 @@
+-1
++one
+ 2
+@@ -7,2 +7,2 @@
+ 7
+-8
++eight
+`
+	c.Assert(UnifiedDiff("old", "new", old, new, 1), Equals, want)
+}
+
+func (s *MySuite) TestUnifiedDiffOfNewFile(c *C) {
+	want := `--- old
++++ new
+@@ -0,0 +1,2 @@
++one
++two
+`
+	c.Assert(UnifiedDiff("old", "new", "", "one\ntwo\n", 3), Equals, want)
+}
+
+func (s *MySuite) TestUnifiedDiffOfNewlineAtEndOfFile(c *C) {
+	want := `--- old
++++ new
+@@ -1,2 +1,2 @@
+ one
+-two
++two
+\ No newline at end of file
+`
+	c.Assert(UnifiedDiff("old", "new", "one\ntwo\n", "one\ntwo", 3), Equals, want)
+
+	want = `--- old
++++ new
+@@ -1,3 +1,2 @@
+ one
+ two
+-
+`
+	c.Assert(UnifiedDiff("old", "new", "one\ntwo\n\n", "one\ntwo\n", 3), Equals, want)
+}
+
+func (s *MySuite) TestUnifiedDiffOfLineEndings(c *C) {
+	want := "--- old\n+++ new\n@@ -1,2 +1,2 @@\n-one\r\n-two\r\n+one\n+two\n"
+	c.Assert(UnifiedDiff("old", "new", "one\r\ntwo\r\n", "one\ntwo\n", 3), Equals, want)
+}
@@ -45,6 +45,11 @@ func init() {
 var AcceptedExtensions = make(map[string]bool)
 var ignoredDirectories = make(map[string]bool)
 
+// dependencyDirectories are the directories of installed dependencies and build output, which are skipped when
+// looking for the files of the project. Only those in the project root are skipped, as a directory with such a name
+// elsewhere, like a data directory in the specs, can belong to the project.
+var dependencyDirectories = map[string]bool{"node_modules": true, "bower_components": true, "target": true, "build": true, "bin": true, "obj": true, "out": true}
+
 func add(value string) {
 	value = strings.TrimSpace(value)
 	if !filepath.IsAbs(value) {
@@ -103,13 +108,48 @@ func IsValidSpecExtension(path string) bool {
 // FindConceptFilesIn Finds the concept files in specified directory
 func FindConceptFilesIn(dir string) []string {
 	addIgnoredDirectories()
-	return findFilesIn(dir, IsValidConceptExtension, func(path string, f os.FileInfo) bool {
-		if !f.IsDir() {
-			return false
+	return findFilesIn(dir, IsValidConceptExtension, skipIgnoredDirectory)
+}
+
+// FindFilesInProject finds the files in the project root for which isValidFile is true,
+// skipping hidden, ignored and dependency directories.
+func FindFilesInProject(isValidFile func(path string) bool) []string {
+	addIgnoredDirectories()
+	return findFilesIn(config.ProjectRoot, isValidFile, skipProjectDirectory)
+}
+
+// FindProjectDirs finds the project root and the directories in it which are searched by FindFilesInProject.
+func FindProjectDirs() []string {
+	addIgnoredDirectories()
+	root, _ := filepath.Abs(config.ProjectRoot)
+	var dirs []string
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+		if path != root && skipProjectDirectory(path, info) {
+			return filepath.SkipDir
 		}
-		_, ok := ignoredDirectories[path]
-		return strings.HasPrefix(f.Name(), ".") || ok
+		dirs = append(dirs, path)
+		return nil
 	})
+	return dirs
+}
+
+func skipProjectDirectory(path string, f os.FileInfo) bool {
+	if skipIgnoredDirectory(path, f) {
+		return true
+	}
+	root, _ := filepath.Abs(config.ProjectRoot)
+	return f.IsDir() && dependencyDirectories[f.Name()] && filepath.Dir(path) == root
+}
+
+func skipIgnoredDirectory(path string, f os.FileInfo) bool {
+	if !f.IsDir() {
+		return false
+	}
+	_, ok := ignoredDirectories[path]
+	return strings.HasPrefix(f.Name(), ".") || ok
 }
 
 // IsValidConceptExtension Checks if the path has a concept file extension
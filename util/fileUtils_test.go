@@ -113,6 +113,41 @@ func (s *MySuite) TestFindAllConceptFilesShouldFilterDirectoriesThatAreSkipped(c
 	c.Assert(len(FindConceptFilesIn(dir)), Equals, 1)
 }
 
+func (s *MySuite) TestFindFilesInProjectSkipsDependencyDirectories(c *C) {
+	config.ProjectRoot = dir
+	data := []byte("name,id")
+	modules, _ := createDirIn(dir, "node_modules")
+	target, _ := createDirIn(dir, "target")
+	git, _ := createDirIn(dir, ".git")
+	createFileIn(modules, "users.csv", data)
+	createFileIn(target, "users.csv", data)
+	createFileIn(git, "users.csv", data)
+	users, _ := createFileIn(dir, "users.csv", data)
+
+	c.Assert(FindFilesInProject(func(path string) bool { return true }), DeepEquals, []string{users})
+}
+
+func (s *MySuite) TestFindProjectDirsSkipsDependencyDirectories(c *C) {
+	config.ProjectRoot = dir
+	createDirIn(dir, "node_modules")
+	data, _ := createDirIn(dir, "data")
+	root, _ := filepath.Abs(dir)
+
+	c.Assert(FindProjectDirs(), DeepEquals, []string{root, data})
+}
+
+func (s *MySuite) TestFindFilesInProjectDoesNotSkipDependencyDirectoriesOutsideProjectRoot(c *C) {
+	config.ProjectRoot = dir
+	data := []byte("name,id")
+	specs, _ := createDirIn(dir, "specs")
+	build, _ := createDirIn(specs, "build")
+	target, _ := createDirIn(dir, "target")
+	createFileIn(target, "users.csv", data)
+	users, _ := createFileIn(build, "users.csv", data)
+
+	c.Assert(FindFilesInProject(func(path string) bool { return true }), DeepEquals, []string{users})
+}
+
 func (s *MySuite) TestFindAllConceptFilesInNestedDir(c *C) {
 	data := []byte(`#Concept Heading
 * Say "hello" to gauge
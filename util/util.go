@@ -112,7 +112,12 @@ func SetWorkingDir(workingDir string) {
 // It checks whether the environment variable for gauge_specs_dir is set.
 // It returns 'specs' otherwise
 func GetSpecDirs() []string {
-	var specFromProperties = os.Getenv(env.SpecsDir)
+	return SpecDirsOf(os.Getenv(env.SpecsDir))
+}
+
+// SpecDirsOf gives the spec directories in the comma separated value of the gauge_specs_dir property, or the default
+// spec directory if it is empty.
+func SpecDirsOf(specFromProperties string) []string {
 	if specFromProperties != "" {
 		var specDirectories = strings.Split(specFromProperties, ",")
 		for index, ele := range specDirectories {
@@ -135,3 +140,35 @@ func ListContains(list []string, val string) bool {
 func GetFileContents(filepath string) (string, error) {
 	return common.ReadFileContents(GetPathToFile(filepath))
 }
+
+// EditDistance gives the number of single character insertions, deletions or substitutions needed to change a into b.
+func EditDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	previous := make([]int, len(t)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		current := make([]int, len(t)+1)
+		current[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(t)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -16,3 +16,11 @@ func (s *MySuite) TestSpecDirEnvVariableAllowsCommaSeparatedList(c *C) {
 	c.Assert(e, Equals, nil)
 	c.Assert(GetSpecDirs(), DeepEquals, []string{"spec1", "spec2", "spec3"})
 }
+
+func (s *MySuite) TestEditDistance(c *C) {
+	c.Assert(EditDistance("", ""), Equals, 0)
+	c.Assert(EditDistance("name", "name"), Equals, 0)
+	c.Assert(EditDistance("nam", "name"), Equals, 1)
+	c.Assert(EditDistance("kitten", "sitting"), Equals, 3)
+	c.Assert(EditDistance("Login as user", "Log in as user"), Equals, 1)
+}
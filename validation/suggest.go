@@ -18,9 +18,19 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/getgauge/gauge/gauge"
 	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
 )
 
 var message = map[gm.StepValidateResponse_ErrorType]string{
@@ -70,3 +80,82 @@ func groupErrors(validationErrors validationErrors) map[gm.StepValidateResponse_
 	}
 	return errMap
 }
+
+// closestMatchOutput is the machine readable form of the closest match of an unimplemented step.
+type closestMatchOutput struct {
+	Type       string `json:"type"`
+	FileName   string `json:"fileName"`
+	LineNo     int    `json:"lineNo"`
+	Step       string `json:"step"`
+	DidYouMean string `json:"didYouMean"`
+}
+
+func printClosestMatch(e StepValidationError) {
+	if !reporter.MachineReadable {
+		logger.Errorf(true, "\tDid you mean '%s'?", e.closestMatch)
+		return
+	}
+	b, err := json.Marshal(closestMatchOutput{Type: "didYouMean", FileName: e.fileName, LineNo: e.step.LineNo, Step: e.step.GetLineText(), DidYouMean: e.closestMatch})
+	if err != nil {
+		logger.Debugf(true, "Failed to convert closest match to JSON. Reason: %s", err.Error())
+		return
+	}
+	fmt.Println(string(b))
+}
+
+// ClosestMatch gives the step text, out of the implemented steps and concept headings, whose step value is most similar
+// to the given step value, or an empty string if none is similar enough. Only texts with the same number of parameters
+// are matched, so that the step can be changed to the match keeping its arguments.
+func ClosestMatch(stepValue string, texts []string) string {
+	sorted := append([]string{}, texts...)
+	sort.Strings(sorted)
+	params := strings.Count(stepValue, gauge.ParameterPlaceholder)
+	maxDistance := utf8.RuneCountInString(stepValue) / 3
+	match, matchDistance := "", maxDistance+1
+	for _, text := range sorted {
+		value, err := parser.ExtractStepValueAndParams(text, false)
+		if err != nil || value.StepValue == stepValue || strings.Count(value.StepValue, gauge.ParameterPlaceholder) != params {
+			continue
+		}
+		if d := util.EditDistance(strings.ToLower(stepValue), strings.ToLower(value.StepValue)); d < matchDistance {
+			match, matchDistance = text, d
+		}
+	}
+	return match
+}
+
+// ImplementedStepTexts gives the steps implemented in the runner and the headings of the concepts, which are the texts
+// an unimplemented step is matched against. The runner can be nil, if it is not started.
+func ImplementedStepTexts(r runner.Runner, concepts []*gm.ConceptInfo) []string {
+	texts := make([]string, 0)
+	if r != nil {
+		m := &gm.Message{MessageType: gm.Message_StepNamesRequest, StepNamesRequest: &gm.StepNamesRequest{}}
+		if res, err := r.ExecuteMessageWithTimeout(m); err == nil {
+			texts = append(texts, res.GetStepNamesResponse().GetSteps()...)
+		}
+	}
+	for _, c := range concepts {
+		texts = append(texts, c.GetStepValue().GetParameterizedStepValue())
+	}
+	return texts
+}
+
+// implementedStepTexts gives the steps implemented in the runner and the concept headings, which are fetched once.
+func (v *SpecValidator) implementedStepTexts() []string {
+	if v.stepTexts == nil {
+		v.stepTexts = ImplementedStepTexts(v.runner, conceptInfos(v.conceptsDictionary))
+	}
+	return v.stepTexts
+}
+
+func conceptInfos(dictionary *gauge.ConceptDictionary) []*gm.ConceptInfo {
+	var concepts []*gm.ConceptInfo
+	if dictionary == nil {
+		return concepts
+	}
+	for _, c := range dictionary.ConceptsMap {
+		stepValue := parser.CreateStepValue(c.ConceptStep)
+		concepts = append(concepts, &gm.ConceptInfo{StepValue: gauge.ConvertToProtoStepValue(&stepValue)})
+	}
+	return concepts
+}
@@ -88,3 +88,24 @@ func TestGetSuggestionMessageForOtherValidationErrors(t *testing.T) {
 		t.Errorf("Wrong suggestion message. got: %v, want: %v", got, want)
 	}
 }
+
+func TestClosestMatch(t *testing.T) {
+	texts := []string{"Log in as user <name>", "Log in as admin", "Log in as user", "Logout", "Open <page> page"}
+	tests := []struct {
+		stepValue string
+		want      string
+	}{
+		{"Login as user", "Log in as user"},
+		{"Login as user {}", "Log in as user <name>"},
+		{"Opne {} page", "Open <page> page"},
+		{"Open page", ""},
+		{"Search for products", ""},
+		{"Log in as user", ""},
+	}
+	for _, test := range tests {
+		got := ClosestMatch(test.stepValue, texts)
+		if got != test.want {
+			t.Errorf("Wrong closest match for %s. got: %v, want: %v", test.stepValue, got, test.want)
+		}
+	}
+}
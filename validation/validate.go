@@ -27,13 +27,16 @@ If there is a validation error it skips that scenario and executes other scenari
 package validation
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/getgauge/gauge/api"
+	"github.com/getgauge/gauge/execution/summary"
 	"github.com/getgauge/gauge/gauge"
 	gm "github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
@@ -50,9 +53,10 @@ var TableRows = ""
 var HideSuggestion bool
 
 type validator struct {
-	specsToExecute     []*gauge.Specification
-	runner             runner.Runner
-	conceptsDictionary *gauge.ConceptDictionary
+	specsToExecute      []*gauge.Specification
+	runner              runner.Runner
+	conceptsDictionary  *gauge.ConceptDictionary
+	stepValidationCache map[string]error
 }
 
 type SpecValidator struct {
@@ -61,14 +65,17 @@ type SpecValidator struct {
 	conceptsDictionary  *gauge.ConceptDictionary
 	validationErrors    []error
 	stepValidationCache map[string]error
+	// stepTexts are the implemented steps and concept headings, which are fetched once an unimplemented step is found.
+	stepTexts []string
 }
 
 type StepValidationError struct {
-	step       *gauge.Step
-	message    string
-	fileName   string
-	errorType  *gm.StepValidateResponse_ErrorType
-	suggestion string
+	step         *gauge.Step
+	message      string
+	fileName     string
+	errorType    *gm.StepValidateResponse_ErrorType
+	suggestion   string
+	closestMatch string
 }
 
 type SpecValidationError struct {
@@ -101,6 +108,12 @@ func (s StepValidationError) Suggestion() string {
 	return s.suggestion
 }
 
+// ClosestMatch gives the implemented step or concept heading which is most similar to an unimplemented step, or an
+// empty string if there is none.
+func (s StepValidationError) ClosestMatch() string {
+	return s.closestMatch
+}
+
 // Error prints a spec validation error with filename and error message.
 func (s SpecValidationError) Error() string {
 	return fmt.Sprintf("%s %s", s.fileName, s.message)
@@ -142,7 +155,7 @@ func Validate(args []string) {
 
 //TODO : duplicate in execute.go. Need to fix runner init.
 func startAPI(debug bool) runner.Runner {
-	sc := api.StartAPI(debug, reporter.Current())
+	sc := api.StartAPI(debug, reporter.RunnerOutput(0))
 	select {
 	case runner := <-sc.RunnerChan:
 		return runner
@@ -167,14 +180,20 @@ func NewValidationResult(s *gauge.SpecCollection, errMap *gauge.BuildErrors, r r
 
 // ValidateSpecs parses the specs, creates a new validator and call the runner to get the validation result.
 func ValidateSpecs(args []string, debug bool) *ValidationResult {
+	start := time.Now()
 	conceptDict, res, err := parser.ParseConcepts()
 	if err != nil {
 		logger.Fatalf(true, "Unable to validate : %s", err.Error())
 	}
 	errMap := gauge.NewBuildErrors()
 	s, specsFailed := parser.ParseSpecs(args, conceptDict, errMap)
+	summary.Record(summary.Parse, time.Since(start))
+	start = time.Now()
 	r := startAPI(debug)
+	summary.Record(summary.RunnerStartup, time.Since(start))
+	start = time.Now()
 	vErrs := NewValidator(s, r, conceptDict).Validate()
+	summary.Record(summary.Validation, time.Since(start))
 	errMap = getErrMap(errMap, vErrs)
 	s = parser.GetSpecsForDataTableRows(s, errMap)
 	printValidationFailures(vErrs)
@@ -244,6 +263,9 @@ func fillSpecErrors(spec *gauge.Specification, errMap *gauge.BuildErrors, steps
 func printValidationFailures(validationErrors validationErrors) {
 	for _, e := range FilterDuplicates(validationErrors) {
 		logger.Errorf(true, "[ValidationError] %s", e.Error())
+		if vErr, ok := e.(StepValidationError); ok && vErr.closestMatch != "" {
+			printClosestMatch(vErr)
+		}
 	}
 }
 
@@ -272,13 +294,29 @@ func FilterDuplicates(validationErrors validationErrors) []error {
 type validationErrors map[*gauge.Specification][]error
 
 func NewValidator(s []*gauge.Specification, r runner.Runner, c *gauge.ConceptDictionary) *validator {
-	return &validator{specsToExecute: s, runner: r, conceptsDictionary: c}
+	return &validator{specsToExecute: s, runner: r, conceptsDictionary: c, stepValidationCache: make(map[string]error)}
+}
+
+// NewValidatorWithCache creates a validator which keeps the validation result of each step value in the given cache.
+// The cache can be passed to later validators, so that a step value is validated with the runner only once.
+func NewValidatorWithCache(s []*gauge.Specification, r runner.Runner, c *gauge.ConceptDictionary, cache map[string]error) *validator {
+	return &validator{specsToExecute: s, runner: r, conceptsDictionary: c, stepValidationCache: cache}
 }
 
 func (v *validator) Validate() validationErrors {
+	validationStatus, _ := v.ValidateContext(context.Background())
+	return validationStatus
+}
+
+// ValidateContext validates the specs like Validate, but stops validating the remaining specs once the context is done
+// and gives the error of the context.
+func (v *validator) ValidateContext(ctx context.Context) (validationErrors, error) {
 	validationStatus := make(validationErrors)
-	specValidator := &SpecValidator{runner: v.runner, conceptsDictionary: v.conceptsDictionary, stepValidationCache: make(map[string]error)}
+	specValidator := &SpecValidator{runner: v.runner, conceptsDictionary: v.conceptsDictionary, stepValidationCache: v.stepValidationCache}
 	for _, spec := range v.specsToExecute {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		specValidator.specification = spec
 		validationErrors := specValidator.validate()
 		if len(validationErrors) != 0 {
@@ -286,9 +324,9 @@ func (v *validator) Validate() validationErrors {
 		}
 	}
 	if len(validationStatus) > 0 {
-		return validationStatus
+		return validationStatus, nil
 	}
-	return nil
+	return nil, nil
 }
 
 func (v *SpecValidator) validate() []error {
@@ -317,14 +355,13 @@ func (v *SpecValidator) Step(s *gauge.Step) {
 	}
 	if val != nil {
 		valErr := val.(StepValidationError)
-		if s.Parent == nil {
-			v.validationErrors = append(v.validationErrors,
-				NewStepValidationError(s, valErr.message, v.specification.FileName, valErr.errorType, valErr.suggestion))
-		} else {
-			cpt := v.conceptsDictionary.Search(s.Parent.Value)
-			v.validationErrors = append(v.validationErrors,
-				NewStepValidationError(s, valErr.message, cpt.FileName, valErr.errorType, valErr.suggestion))
+		fileName := v.specification.FileName
+		if s.Parent != nil {
+			fileName = v.conceptsDictionary.Search(s.Parent.Value).FileName
 		}
+		vErr := NewStepValidationError(s, valErr.message, fileName, valErr.errorType, valErr.suggestion)
+		vErr.closestMatch = valErr.closestMatch
+		v.validationErrors = append(v.validationErrors, vErr)
 	}
 }
 
@@ -349,12 +386,14 @@ func (v *SpecValidator) validateStep(s *gauge.Step) error {
 		if !res.GetIsValid() {
 			msg := getMessage(res.GetErrorType().String())
 			suggestion := res.GetSuggestion()
-			if s.Parent == nil {
-				vErr := NewStepValidationError(s, msg, v.specification.FileName, &res.ErrorType, suggestion)
-				return vErr
+			fileName := v.specification.FileName
+			if s.Parent != nil {
+				fileName = v.conceptsDictionary.Search(s.Parent.Value).FileName
+			}
+			vErr := NewStepValidationError(s, msg, fileName, &res.ErrorType, suggestion)
+			if res.GetErrorType() == gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
+				vErr.closestMatch = ClosestMatch(s.Value, v.implementedStepTexts())
 			}
-			cpt := v.conceptsDictionary.Search(s.Parent.Value)
-			vErr := NewStepValidationError(s, msg, cpt.FileName, &res.ErrorType, suggestion)
 			return vErr
 
 		}
@@ -18,6 +18,7 @@
 package validation
 
 import (
+	"context"
 	"net"
 	"testing"
 
@@ -199,6 +200,83 @@ func (s *MySuite) TestValidateStepInConcept(c *C) {
 		"}")
 }
 
+func (s *MySuite) TestValidateContextStopsWhenCancelled(c *C) {
+	validated := false
+	runner := &mockRunner{
+		ExecuteMessageFunc: func(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+			validated = true
+			res := &gauge_messages.StepValidateResponse{IsValid: true}
+			return &gauge_messages.Message{MessageType: gauge_messages.Message_StepValidateResponse, StepValidateResponse: res}, nil
+		},
+	}
+	spec := &gauge.Specification{FileName: "foo.spec", Contexts: []*gauge.Step{{Value: "my step", LineText: "my step", LineNo: 3}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs, err := NewValidator([]*gauge.Specification{spec}, runner, gauge.NewConceptDictionary()).ValidateContext(ctx)
+
+	c.Assert(err, Equals, context.Canceled)
+	c.Assert(len(errs), Equals, 0)
+	c.Assert(validated, Equals, false)
+}
+
+func (s *MySuite) TestValidateStepGivesClosestImplementedStepOrConcept(c *C) {
+	myStep := &gauge.Step{Value: "Login as user {}", LineText: "Login as user \"john\"", LineNo: 3, Args: []*gauge.StepArg{{ArgType: gauge.Static, Value: "john"}}}
+	cptDict := gauge.NewConceptDictionary()
+	cptDict.ConceptsMap["sign in as {}"] = &gauge.Concept{ConceptStep: &gauge.Step{Value: "sign in as {}", LineText: "sign in as <user>", Args: []*gauge.StepArg{{ArgType: gauge.Dynamic, Value: "user"}}}, FileName: "concept.cpt"}
+	runner := &mockRunner{
+		ExecuteMessageFunc: func(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+			if m.MessageType == gauge_messages.Message_StepNamesRequest {
+				res := &gauge_messages.StepNamesResponse{Steps: []string{"Log in as user <name>", "Log out"}}
+				return &gauge_messages.Message{MessageType: gauge_messages.Message_StepNamesResponse, StepNamesResponse: res}, nil
+			}
+			res := &gauge_messages.StepValidateResponse{IsValid: false, ErrorType: gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND}
+			return &gauge_messages.Message{MessageType: gauge_messages.Message_StepValidateResponse, StepValidateResponse: res}, nil
+		},
+	}
+	specVal := &SpecValidator{specification: &gauge.Specification{FileName: "foo.spec"}, conceptsDictionary: cptDict, runner: runner}
+
+	valErr := specVal.validateStep(myStep)
+
+	c.Assert(valErr, Not(Equals), nil)
+	c.Assert(valErr.(StepValidationError).ClosestMatch(), Equals, "Log in as user <name>")
+}
+
+func (s *MySuite) TestValidateStepGivesClosestConceptHeading(c *C) {
+	myStep := &gauge.Step{Value: "sign on as {}", LineText: "sign on as \"john\"", LineNo: 3, Args: []*gauge.StepArg{{ArgType: gauge.Static, Value: "john"}}}
+	cptDict := gauge.NewConceptDictionary()
+	cptDict.ConceptsMap["sign in as {}"] = &gauge.Concept{ConceptStep: &gauge.Step{Value: "sign in as {}", LineText: "sign in as <user>", Args: []*gauge.StepArg{{ArgType: gauge.Dynamic, Value: "user"}}}, FileName: "concept.cpt"}
+	runner := &mockRunner{
+		ExecuteMessageFunc: func(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+			if m.MessageType == gauge_messages.Message_StepNamesRequest {
+				res := &gauge_messages.StepNamesResponse{Steps: []string{"Log out"}}
+				return &gauge_messages.Message{MessageType: gauge_messages.Message_StepNamesResponse, StepNamesResponse: res}, nil
+			}
+			res := &gauge_messages.StepValidateResponse{IsValid: false, ErrorType: gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND}
+			return &gauge_messages.Message{MessageType: gauge_messages.Message_StepValidateResponse, StepValidateResponse: res}, nil
+		},
+	}
+	specVal := &SpecValidator{specification: &gauge.Specification{FileName: "foo.spec"}, conceptsDictionary: cptDict, runner: runner}
+
+	valErr := specVal.validateStep(myStep)
+
+	c.Assert(valErr, Not(Equals), nil)
+	c.Assert(valErr.(StepValidationError).ClosestMatch(), Equals, "sign in as <user>")
+}
+
+func (s *MySuite) TestCachedStepValidationKeepsClosestMatch(c *C) {
+	implNotFound := gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND
+	myStep := &gauge.Step{Value: "Login as user", LineText: "Login as user", LineNo: 5}
+	cached := StepValidationError{step: myStep, message: "Step implementation not found", errorType: &implNotFound, closestMatch: "Log in as user"}
+	specVal := &SpecValidator{specification: &gauge.Specification{FileName: "bar.spec"}, stepValidationCache: map[string]error{"Login as user": cached}}
+
+	specVal.Step(myStep)
+
+	c.Assert(specVal.validationErrors, HasLen, 1)
+	c.Assert(specVal.validationErrors[0].(StepValidationError).FileName(), Equals, "bar.spec")
+	c.Assert(specVal.validationErrors[0].(StepValidationError).ClosestMatch(), Equals, "Log in as user")
+}
+
 func (s *MySuite) TestFilterDuplicateValidationErrors(c *C) {
 	specText := `Specification Heading
 =====================